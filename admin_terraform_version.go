@@ -0,0 +1,257 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ AdminTerraformVersions = (*adminTerraformVersions)(nil)
+
+// AdminTerraformVersions describes all the admin terraform version related
+// methods that the Terraform Enterprise API supports. These methods are only
+// available to site administrators of a private installation.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/enterprise/api/admin/terraform-versions.html
+type AdminTerraformVersions interface {
+	// List all the terraform versions.
+	List(ctx context.Context, options AdminTerraformVersionsListOptions) (*AdminTerraformVersionsList, error)
+
+	// Create a terraform version.
+	Create(ctx context.Context, options AdminTerraformVersionCreateOptions) (*AdminTerraformVersion, error)
+
+	// Read a terraform version by its ID.
+	Read(ctx context.Context, id string) (*AdminTerraformVersion, error)
+
+	// Update a terraform version by its ID.
+	Update(ctx context.Context, id string, options AdminTerraformVersionUpdateOptions) (*AdminTerraformVersion, error)
+
+	// Delete a terraform version by its ID.
+	Delete(ctx context.Context, id string) error
+}
+
+// adminTerraformVersions implements AdminTerraformVersions.
+type adminTerraformVersions struct {
+	client *Client
+}
+
+// AdminTerraformVersion represents a Terraform version.
+type AdminTerraformVersion struct {
+	ID         string    `jsonapi:"primary,terraform-versions"`
+	Beta       bool      `jsonapi:"attr,beta"`
+	CreatedAt  time.Time `jsonapi:"attr,created-at,iso8601"`
+	Deprecated bool      `jsonapi:"attr,deprecated"`
+	Enabled    bool      `jsonapi:"attr,enabled"`
+	Official   bool      `jsonapi:"attr,official"`
+	Sha        string    `jsonapi:"attr,sha"`
+	URL        string    `jsonapi:"attr,url"`
+	Usage      int       `jsonapi:"attr,usage"`
+	Version    string    `jsonapi:"attr,version"`
+}
+
+// AdminTerraformVersionsList represents a list of terraform versions.
+type AdminTerraformVersionsList struct {
+	*Pagination
+	Items []*AdminTerraformVersion
+}
+
+// AdminTerraformVersionsListOptions represents the options for listing
+// terraform versions.
+type AdminTerraformVersionsListOptions struct {
+	ListOptions
+
+	// A version string used to filter the results.
+	Filter *string `url:"filter[version],omitempty"`
+}
+
+// List all the terraform versions.
+func (s *adminTerraformVersions) List(ctx context.Context, options AdminTerraformVersionsListOptions) (*AdminTerraformVersionsList, error) {
+	req, err := s.client.newRequest("GET", "admin/terraform-versions", &options)
+	if err != nil {
+		return nil, err
+	}
+
+	tvl := &AdminTerraformVersionsList{}
+	err = s.client.do(ctx, req, tvl)
+	if err != nil {
+		return nil, err
+	}
+
+	return tvl, nil
+}
+
+// AdminTerraformVersionCreateOptions represents the options for creating a
+// terraform version.
+type AdminTerraformVersionCreateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,terraform-versions"`
+
+	// The version number, e.g. "0.12.24".
+	Version *string `jsonapi:"attr,version"`
+
+	// The URL of the zip file containing the terraform binary.
+	URL *string `jsonapi:"attr,url"`
+
+	// The SHA-256 checksum of the zip file.
+	Sha *string `jsonapi:"attr,sha"`
+
+	// Whether this is an official release of Terraform.
+	Official *bool `jsonapi:"attr,official,omitempty"`
+
+	// Whether workspaces can select this version.
+	Enabled *bool `jsonapi:"attr,enabled,omitempty"`
+
+	// Whether this version is a beta release.
+	Beta *bool `jsonapi:"attr,beta,omitempty"`
+
+	// Whether this version is deprecated.
+	Deprecated *bool `jsonapi:"attr,deprecated,omitempty"`
+}
+
+// Validate checks the admin Terraform version create options for errors,
+// without making an API request.
+func (o AdminTerraformVersionCreateOptions) Validate() error {
+	if !validString(o.Version) {
+		return validationError("version is required")
+	}
+	if !validString(o.URL) {
+		return validationError("url is required")
+	}
+	if !validString(o.Sha) {
+		return validationError("sha is required")
+	}
+	return nil
+}
+
+// Create a terraform version.
+func (s *adminTerraformVersions) Create(ctx context.Context, options AdminTerraformVersionCreateOptions) (*AdminTerraformVersion, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	req, err := s.client.newRequest("POST", "admin/terraform-versions", &options)
+	if err != nil {
+		return nil, err
+	}
+
+	tv := &AdminTerraformVersion{}
+	err = s.client.do(ctx, req, tv)
+	if err != nil {
+		return nil, err
+	}
+
+	return tv, nil
+}
+
+// Read a terraform version by its ID.
+func (s *adminTerraformVersions) Read(ctx context.Context, id string) (*AdminTerraformVersion, error) {
+	if !validStringID(&id) {
+		return nil, errors.New("invalid value for terraform version ID")
+	}
+
+	u := fmt.Sprintf("admin/terraform-versions/%s", url.QueryEscape(id))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tv := &AdminTerraformVersion{}
+	err = s.client.do(ctx, req, tv)
+	if err != nil {
+		return nil, err
+	}
+
+	return tv, nil
+}
+
+// AdminTerraformVersionUpdateOptions represents the options for updating a
+// terraform version.
+type AdminTerraformVersionUpdateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,terraform-versions"`
+
+	// A new version number.
+	Version *string `jsonapi:"attr,version,omitempty"`
+
+	// A new URL of the zip file containing the terraform binary.
+	URL *string `jsonapi:"attr,url,omitempty"`
+
+	// A new SHA-256 checksum of the zip file.
+	Sha *string `jsonapi:"attr,sha,omitempty"`
+
+	// Whether this is an official release of Terraform.
+	Official *bool `jsonapi:"attr,official,omitempty"`
+
+	// Whether workspaces can select this version.
+	Enabled *bool `jsonapi:"attr,enabled,omitempty"`
+
+	// Whether this version is a beta release.
+	Beta *bool `jsonapi:"attr,beta,omitempty"`
+
+	// Whether this version is deprecated.
+	Deprecated *bool `jsonapi:"attr,deprecated,omitempty"`
+}
+
+// Validate checks the admin Terraform version update options for errors,
+// without making an API request.
+func (o AdminTerraformVersionUpdateOptions) Validate() error {
+	if o.Version != nil && !validString(o.Version) {
+		return validationError("invalid value for version")
+	}
+	if o.URL != nil && !validString(o.URL) {
+		return validationError("invalid value for url")
+	}
+	if o.Sha != nil && !validString(o.Sha) {
+		return validationError("invalid value for sha")
+	}
+	return nil
+}
+
+// Update a terraform version by its ID.
+func (s *adminTerraformVersions) Update(ctx context.Context, id string, options AdminTerraformVersionUpdateOptions) (*AdminTerraformVersion, error) {
+	if !validStringID(&id) {
+		return nil, errors.New("invalid value for terraform version ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("admin/terraform-versions/%s", url.QueryEscape(id))
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	tv := &AdminTerraformVersion{}
+	err = s.client.do(ctx, req, tv)
+	if err != nil {
+		return nil, err
+	}
+
+	return tv, nil
+}
+
+// Delete a terraform version by its ID.
+func (s *adminTerraformVersions) Delete(ctx context.Context, id string) error {
+	if !validStringID(&id) {
+		return errors.New("invalid value for terraform version ID")
+	}
+
+	u := fmt.Sprintf("admin/terraform-versions/%s", url.QueryEscape(id))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
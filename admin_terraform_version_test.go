@@ -0,0 +1,174 @@
+package tfe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminTerraformVersionsList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	tvTest, tvTestCleanup := createAdminTerraformVersion(t, client)
+	defer tvTestCleanup()
+
+	t.Run("without list options", func(t *testing.T) {
+		tvl, err := client.AdminTerraformVersions.List(ctx, AdminTerraformVersionsListOptions{})
+		require.NoError(t, err)
+		assert.NotEmpty(t, tvl.Items)
+	})
+
+	t.Run("with a version filter", func(t *testing.T) {
+		tvl, err := client.AdminTerraformVersions.List(ctx, AdminTerraformVersionsListOptions{
+			Filter: String(tvTest.Version),
+		})
+		require.NoError(t, err)
+		require.Len(t, tvl.Items, 1)
+		assert.Equal(t, tvTest.ID, tvl.Items[0].ID)
+	})
+}
+
+func TestAdminTerraformVersionsCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("with valid options", func(t *testing.T) {
+		options := AdminTerraformVersionCreateOptions{
+			Version:    String("0.0." + randomString(t)),
+			URL:        String("https://www.hashicorp.com"),
+			Sha:        String("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
+			Enabled:    Bool(true),
+			Beta:       Bool(true),
+			Deprecated: Bool(false),
+		}
+
+		tv, err := client.AdminTerraformVersions.Create(ctx, options)
+		require.NoError(t, err)
+		defer client.AdminTerraformVersions.Delete(ctx, tv.ID)
+
+		assert.Equal(t, *options.Version, tv.Version)
+		assert.Equal(t, *options.URL, tv.URL)
+		assert.Equal(t, *options.Sha, tv.Sha)
+		assert.True(t, tv.Enabled)
+		assert.True(t, tv.Beta)
+		assert.False(t, tv.Deprecated)
+	})
+
+	t.Run("without a version", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Create(ctx, AdminTerraformVersionCreateOptions{
+			URL: String("https://www.hashicorp.com"),
+			Sha: String("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
+		})
+		assert.Nil(t, tv)
+		assert.EqualError(t, err, "version is required")
+	})
+
+	t.Run("without a url", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Create(ctx, AdminTerraformVersionCreateOptions{
+			Version: String("0.0." + randomString(t)),
+			Sha:     String("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
+		})
+		assert.Nil(t, tv)
+		assert.EqualError(t, err, "url is required")
+	})
+
+	t.Run("without a sha", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Create(ctx, AdminTerraformVersionCreateOptions{
+			Version: String("0.0." + randomString(t)),
+			URL:     String("https://www.hashicorp.com"),
+		})
+		assert.Nil(t, tv)
+		assert.EqualError(t, err, "sha is required")
+	})
+}
+
+func TestAdminTerraformVersionsRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	tvTest, tvTestCleanup := createAdminTerraformVersion(t, client)
+	defer tvTestCleanup()
+
+	t.Run("when the terraform version exists", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Read(ctx, tvTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, tvTest, tv)
+	})
+
+	t.Run("when the terraform version does not exist", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Read(ctx, "nonexisting")
+		assert.Nil(t, tv)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid terraform version ID", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Read(ctx, badIdentifier)
+		assert.Nil(t, tv)
+		assert.EqualError(t, err, "invalid value for terraform version ID")
+	})
+}
+
+func TestAdminTerraformVersionsUpdate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	tvTest, tvTestCleanup := createAdminTerraformVersion(t, client)
+	defer tvTestCleanup()
+
+	t.Run("with valid options", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Update(ctx, tvTest.ID, AdminTerraformVersionUpdateOptions{
+			Enabled:    Bool(false),
+			Deprecated: Bool(true),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tvTest.Version, tv.Version)
+		assert.False(t, tv.Enabled)
+		assert.True(t, tv.Deprecated)
+	})
+
+	t.Run("with a blank version", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Update(ctx, tvTest.ID, AdminTerraformVersionUpdateOptions{
+			Version: String(""),
+		})
+		assert.Nil(t, tv)
+		assert.EqualError(t, err, "invalid value for version")
+	})
+
+	t.Run("with a blank url", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Update(ctx, tvTest.ID, AdminTerraformVersionUpdateOptions{
+			URL: String(""),
+		})
+		assert.Nil(t, tv)
+		assert.EqualError(t, err, "invalid value for url")
+	})
+
+	t.Run("with invalid terraform version ID", func(t *testing.T) {
+		tv, err := client.AdminTerraformVersions.Update(ctx, badIdentifier, AdminTerraformVersionUpdateOptions{})
+		assert.Nil(t, tv)
+		assert.EqualError(t, err, "invalid value for terraform version ID")
+	})
+}
+
+func TestAdminTerraformVersionsDelete(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	tvTest, _ := createAdminTerraformVersion(t, client)
+
+	t.Run("with valid options", func(t *testing.T) {
+		err := client.AdminTerraformVersions.Delete(ctx, tvTest.ID)
+		require.NoError(t, err)
+
+		// Try loading the terraform version - it should fail.
+		_, err = client.AdminTerraformVersions.Read(ctx, tvTest.ID)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid terraform version ID", func(t *testing.T) {
+		err := client.AdminTerraformVersions.Delete(ctx, badIdentifier)
+		assert.EqualError(t, err, "invalid value for terraform version ID")
+	})
+}
@@ -0,0 +1,130 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Agents = (*agents)(nil)
+
+// Agents describes all the agent related methods that the Terraform
+// Enterprise API supports.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/agents.html
+type Agents interface {
+	// List all the agents of the given agent pool.
+	List(ctx context.Context, agentPoolID string, options AgentListOptions) (*AgentList, error)
+
+	// Read an agent by its ID.
+	Read(ctx context.Context, agentID string) (*Agent, error)
+
+	// Delete an agent by its ID. Only agents that are no longer connected
+	// can be deleted.
+	Delete(ctx context.Context, agentID string) error
+}
+
+// agents implements Agents.
+type agents struct {
+	client *Client
+}
+
+// AgentStatus represents the status of an agent.
+type AgentStatus string
+
+// List all available agent statuses.
+const (
+	AgentBusy    AgentStatus = "busy"
+	AgentErrored AgentStatus = "errored"
+	AgentExited  AgentStatus = "exited"
+	AgentIdle    AgentStatus = "idle"
+	AgentUnknown AgentStatus = "unknown"
+)
+
+// AgentList represents a list of agents.
+type AgentList struct {
+	*Pagination
+	Items []*Agent
+}
+
+// Agent represents a Terraform Enterprise agent.
+type Agent struct {
+	ID         string      `jsonapi:"primary,agents"`
+	IP         string      `jsonapi:"attr,ip-address"`
+	LastPingAt time.Time   `jsonapi:"attr,last-ping-at,iso8601"`
+	Name       string      `jsonapi:"attr,name"`
+	Status     AgentStatus `jsonapi:"attr,status"`
+
+	// Relations
+	AgentPool *AgentPool `jsonapi:"relation,agent-pool"`
+}
+
+// AgentListOptions represents the options for listing agents.
+type AgentListOptions struct {
+	ListOptions
+
+	// Only list the agents that pinged since the given time.
+	LastPingSince *time.Time `url:"filter[last-ping-since],omitempty"`
+}
+
+// List all the agents of the given agent pool.
+func (s *agents) List(ctx context.Context, agentPoolID string, options AgentListOptions) (*AgentList, error) {
+	if !validStringID(&agentPoolID) {
+		return nil, errors.New("invalid value for agent pool ID")
+	}
+
+	u := fmt.Sprintf("agent-pools/%s/agents", url.QueryEscape(agentPoolID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	al := &AgentList{}
+	err = s.client.do(ctx, req, al)
+	if err != nil {
+		return nil, err
+	}
+
+	return al, nil
+}
+
+// Read an agent by its ID.
+func (s *agents) Read(ctx context.Context, agentID string) (*Agent, error) {
+	if !validStringID(&agentID) {
+		return nil, errors.New("invalid value for agent ID")
+	}
+
+	u := fmt.Sprintf("agents/%s", url.QueryEscape(agentID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Agent{}
+	err = s.client.do(ctx, req, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Delete an agent by its ID. Only agents that are no longer connected can be
+// deleted.
+func (s *agents) Delete(ctx context.Context, agentID string) error {
+	if !validStringID(&agentID) {
+		return errors.New("invalid value for agent ID")
+	}
+
+	u := fmt.Sprintf("agents/%s", url.QueryEscape(agentID))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
@@ -0,0 +1,270 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Compile-time proof of interface implementation.
+var _ AgentPools = (*agentPools)(nil)
+
+// AgentPools describes all the agent pool related methods that the Terraform
+// Enterprise API supports.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/agents.html
+type AgentPools interface {
+	// List all the agent pools of the given organization.
+	List(ctx context.Context, organization string, options AgentPoolListOptions) (*AgentPoolList, error)
+
+	// Create a new agent pool with the given options.
+	Create(ctx context.Context, organization string, options AgentPoolCreateOptions) (*AgentPool, error)
+
+	// Read an agent pool by its ID.
+	Read(ctx context.Context, agentPoolID string) (*AgentPool, error)
+
+	// Update an agent pool by its ID.
+	Update(ctx context.Context, agentPoolID string, options AgentPoolUpdateOptions) (*AgentPool, error)
+
+	// Delete an agent pool by its ID.
+	Delete(ctx context.Context, agentPoolID string) error
+
+	// SetWorkspaces sets the workspaces allowed to use an agent pool to
+	// exactly the workspaces with the given IDs.
+	SetWorkspaces(ctx context.Context, agentPoolID string, workspaceIDs []string) (*AgentPool, error)
+}
+
+// agentPools implements AgentPools.
+type agentPools struct {
+	client *Client
+}
+
+// AgentPoolList represents a list of agent pools.
+type AgentPoolList struct {
+	*Pagination
+	Items []*AgentPool
+}
+
+// AgentPool represents a Terraform Enterprise agent pool.
+type AgentPool struct {
+	ID                 string `jsonapi:"primary,agent-pools"`
+	Name               string `jsonapi:"attr,name"`
+	OrganizationScoped bool   `jsonapi:"attr,organization-scoped"`
+
+	// Relations
+	AllowedWorkspaces []*Workspace  `jsonapi:"relation,allowed-workspaces"`
+	Organization      *Organization `jsonapi:"relation,organization"`
+}
+
+// AgentPoolListOptions represents the options for listing agent pools.
+type AgentPoolListOptions struct {
+	ListOptions
+}
+
+// List all the agent pools of the given organization.
+func (s *agentPools) List(ctx context.Context, organization string, options AgentPoolListOptions) (*AgentPoolList, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s/agent-pools", url.QueryEscape(organization))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	apl := &AgentPoolList{}
+	err = s.client.do(ctx, req, apl)
+	if err != nil {
+		return nil, err
+	}
+
+	return apl, nil
+}
+
+// AgentPoolCreateOptions represents the options for creating an agent pool.
+type AgentPoolCreateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,agent-pools"`
+
+	// A name to identify the agent pool.
+	Name *string `jsonapi:"attr,name"`
+
+	// Whether the agent pool can be used by all the workspaces of the
+	// organization, or only by its allowed workspaces.
+	OrganizationScoped *bool `jsonapi:"attr,organization-scoped,omitempty"`
+
+	// The workspaces allowed to use the agent pool when it is not
+	// organization scoped.
+	AllowedWorkspaces []*Workspace `jsonapi:"relation,allowed-workspaces,omitempty"`
+}
+
+// Validate checks the agent pool create options for errors, without making an
+// API request.
+func (o AgentPoolCreateOptions) Validate() error {
+	if !validString(o.Name) {
+		return validationError("name is required")
+	}
+	if !validStringID(o.Name) {
+		return validationError("invalid value for name")
+	}
+	return nil
+}
+
+// Create a new agent pool with the given options.
+func (s *agentPools) Create(ctx context.Context, organization string, options AgentPoolCreateOptions) (*AgentPool, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("organizations/%s/agent-pools", url.QueryEscape(organization))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := &AgentPool{}
+	err = s.client.do(ctx, req, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// Read an agent pool by its ID.
+func (s *agentPools) Read(ctx context.Context, agentPoolID string) (*AgentPool, error) {
+	if !validStringID(&agentPoolID) {
+		return nil, errors.New("invalid value for agent pool ID")
+	}
+
+	u := fmt.Sprintf("agent-pools/%s", url.QueryEscape(agentPoolID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := &AgentPool{}
+	err = s.client.do(ctx, req, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// AgentPoolUpdateOptions represents the options for updating an agent pool.
+type AgentPoolUpdateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,agent-pools"`
+
+	// A new name to identify the agent pool.
+	Name *string `jsonapi:"attr,name,omitempty"`
+
+	// Whether the agent pool can be used by all the workspaces of the
+	// organization, or only by its allowed workspaces.
+	OrganizationScoped *bool `jsonapi:"attr,organization-scoped,omitempty"`
+}
+
+// Validate checks the agent pool update options for errors, without making an
+// API request.
+func (o AgentPoolUpdateOptions) Validate() error {
+	if o.Name != nil && !validStringID(o.Name) {
+		return validationError("invalid value for name")
+	}
+	return nil
+}
+
+// Update an agent pool by its ID.
+func (s *agentPools) Update(ctx context.Context, agentPoolID string, options AgentPoolUpdateOptions) (*AgentPool, error) {
+	if !validStringID(&agentPoolID) {
+		return nil, errors.New("invalid value for agent pool ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("agent-pools/%s", url.QueryEscape(agentPoolID))
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := &AgentPool{}
+	err = s.client.do(ctx, req, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// Delete an agent pool by its ID.
+func (s *agentPools) Delete(ctx context.Context, agentPoolID string) error {
+	if !validStringID(&agentPoolID) {
+		return errors.New("invalid value for agent pool ID")
+	}
+
+	u := fmt.Sprintf("agent-pools/%s", url.QueryEscape(agentPoolID))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// agentPoolAllowedWorkspacesOptions is used to replace the allowed workspaces
+// of an agent pool.
+type agentPoolAllowedWorkspacesOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,agent-pools"`
+
+	// Must not be omitted when empty, so all workspaces can be removed.
+	AllowedWorkspaces []*Workspace `jsonapi:"relation,allowed-workspaces"`
+}
+
+// SetWorkspaces sets the workspaces allowed to use an agent pool to exactly
+// the workspaces with the given IDs. An empty list removes all workspaces.
+// The allowed workspaces only restrict the agent pool when it is not
+// organization scoped, which can be changed using Update.
+func (s *agentPools) SetWorkspaces(ctx context.Context, agentPoolID string, workspaceIDs []string) (*AgentPool, error) {
+	if !validStringID(&agentPoolID) {
+		return nil, errors.New("invalid value for agent pool ID")
+	}
+
+	options := agentPoolAllowedWorkspacesOptions{AllowedWorkspaces: []*Workspace{}}
+	for _, id := range workspaceIDs {
+		if !validStringID(&id) {
+			return nil, errors.New("invalid value for workspace ID")
+		}
+		options.AllowedWorkspaces = append(options.AllowedWorkspaces, &Workspace{ID: id})
+	}
+
+	u := fmt.Sprintf("agent-pools/%s", url.QueryEscape(agentPoolID))
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := &AgentPool{}
+	err = s.client.do(ctx, req, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
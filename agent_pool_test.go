@@ -0,0 +1,223 @@
+package tfe
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgentPoolsList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	poolTest1, _ := createAgentPool(t, client, orgTest)
+	poolTest2, _ := createAgentPool(t, client, orgTest)
+
+	t.Run("without list options", func(t *testing.T) {
+		pl, err := client.AgentPools.List(ctx, orgTest.Name, AgentPoolListOptions{})
+		require.NoError(t, err)
+		assert.Contains(t, pl.Items, poolTest1)
+		assert.Contains(t, pl.Items, poolTest2)
+		assert.Equal(t, 1, pl.CurrentPage)
+		assert.Equal(t, 2, pl.TotalCount)
+	})
+
+	t.Run("with list options", func(t *testing.T) {
+		// Request a page number which is out of range. The result should
+		// be successful, but return no results if the paging options are
+		// properly passed along.
+		pl, err := client.AgentPools.List(ctx, orgTest.Name, AgentPoolListOptions{
+			ListOptions: ListOptions{
+				PageNumber: 999,
+				PageSize:   100,
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, pl.Items)
+		assert.Equal(t, 999, pl.CurrentPage)
+		assert.Equal(t, 2, pl.TotalCount)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		pl, err := client.AgentPools.List(ctx, badIdentifier, AgentPoolListOptions{})
+		assert.Nil(t, pl)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
+func TestAgentPoolsCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	t.Run("with valid options", func(t *testing.T) {
+		options := AgentPoolCreateOptions{
+			Name: String(randomString(t)),
+		}
+
+		pool, err := client.AgentPools.Create(ctx, orgTest.Name, options)
+		require.NoError(t, err)
+
+		// Get a refreshed view from the API.
+		refreshed, err := client.AgentPools.Read(ctx, pool.ID)
+		require.NoError(t, err)
+
+		for _, item := range []*AgentPool{
+			pool,
+			refreshed,
+		} {
+			assert.NotEmpty(t, item.ID)
+			assert.Equal(t, *options.Name, item.Name)
+		}
+	})
+
+	t.Run("when options is missing name", func(t *testing.T) {
+		pool, err := client.AgentPools.Create(ctx, orgTest.Name, AgentPoolCreateOptions{})
+		assert.Nil(t, pool)
+		assert.EqualError(t, err, "name is required")
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		pool, err := client.AgentPools.Create(ctx, badIdentifier, AgentPoolCreateOptions{
+			Name: String("foo"),
+		})
+		assert.Nil(t, pool)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
+func TestAgentPoolsRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	poolTest, poolTestCleanup := createAgentPool(t, client, nil)
+	defer poolTestCleanup()
+
+	t.Run("when the agent pool exists", func(t *testing.T) {
+		pool, err := client.AgentPools.Read(ctx, poolTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, poolTest.ID, pool.ID)
+		assert.Equal(t, poolTest.Name, pool.Name)
+	})
+
+	t.Run("when the agent pool does not exist", func(t *testing.T) {
+		pool, err := client.AgentPools.Read(ctx, "apool-nonexisting")
+		assert.Nil(t, pool)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid agent pool ID", func(t *testing.T) {
+		pool, err := client.AgentPools.Read(ctx, badIdentifier)
+		assert.Nil(t, pool)
+		assert.EqualError(t, err, "invalid value for agent pool ID")
+	})
+}
+
+func TestAgentPoolsUpdate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	poolTest, poolTestCleanup := createAgentPool(t, client, nil)
+	defer poolTestCleanup()
+
+	t.Run("with valid options", func(t *testing.T) {
+		options := AgentPoolUpdateOptions{
+			Name: String(randomString(t)),
+		}
+
+		pool, err := client.AgentPools.Update(ctx, poolTest.ID, options)
+		require.NoError(t, err)
+		assert.Equal(t, *options.Name, pool.Name)
+	})
+
+	t.Run("with an invalid name", func(t *testing.T) {
+		pool, err := client.AgentPools.Update(ctx, poolTest.ID, AgentPoolUpdateOptions{
+			Name: String(badIdentifier),
+		})
+		assert.Nil(t, pool)
+		assert.EqualError(t, err, "invalid value for name")
+	})
+
+	t.Run("without a valid agent pool ID", func(t *testing.T) {
+		pool, err := client.AgentPools.Update(ctx, badIdentifier, AgentPoolUpdateOptions{})
+		assert.Nil(t, pool)
+		assert.EqualError(t, err, "invalid value for agent pool ID")
+	})
+}
+
+func TestAgentPoolsSetWorkspaces(t *testing.T) {
+	var body string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/tfe/v2/agent-pools/apool-123", r.URL.Path)
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.Write([]byte(`{"data": {"id": "apool-123", "type": "agent-pools", "attributes": {"name": "sensitive", "organization-scoped": false},
+			"relationships": {"allowed-workspaces": {"data": [{"id": "ws-1", "type": "workspaces"}, {"id": "ws-2", "type": "workspaces"}]}}}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with workspaces", func(t *testing.T) {
+		pool, err := client.AgentPools.SetWorkspaces(ctx, "apool-123", []string{"ws-1", "ws-2"})
+		require.NoError(t, err)
+		assert.False(t, pool.OrganizationScoped)
+		require.Len(t, pool.AllowedWorkspaces, 2)
+		assert.Equal(t, "ws-1", pool.AllowedWorkspaces[0].ID)
+
+		assert.Contains(t, body, `"allowed-workspaces":{"data":[{"type":"workspaces","id":"ws-1"},{"type":"workspaces","id":"ws-2"}]}`)
+	})
+
+	t.Run("without workspaces", func(t *testing.T) {
+		_, err := client.AgentPools.SetWorkspaces(ctx, "apool-123", nil)
+		require.NoError(t, err)
+		assert.Contains(t, body, `"allowed-workspaces":{"data":[]}`)
+	})
+
+	t.Run("when scoping to the allowed workspaces", func(t *testing.T) {
+		_, err := client.AgentPools.Update(ctx, "apool-123", AgentPoolUpdateOptions{
+			OrganizationScoped: Bool(false),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, body, `"organization-scoped":false`)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		pool, err := client.AgentPools.SetWorkspaces(ctx, "apool-123", []string{badIdentifier})
+		assert.Nil(t, pool)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestAgentPoolsDelete(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	poolTest, _ := createAgentPool(t, client, orgTest)
+
+	t.Run("with valid options", func(t *testing.T) {
+		err := client.AgentPools.Delete(ctx, poolTest.ID)
+		require.NoError(t, err)
+
+		// Try loading the agent pool - it should fail.
+		_, err = client.AgentPools.Read(ctx, poolTest.ID)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid agent pool ID", func(t *testing.T) {
+		err := client.AgentPools.Delete(ctx, badIdentifier)
+		assert.EqualError(t, err, "invalid value for agent pool ID")
+	})
+}
@@ -0,0 +1,90 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgents(t *testing.T) {
+	var requests []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/tfe/v2/agent-pools/apool-123/agents":
+			w.Write([]byte(`{
+				"data": [
+					{"id": "agent-1", "type": "agents", "attributes": {"name": "one", "status": "idle", "ip-address": "10.0.0.1", "last-ping-at": "2021-06-01T10:00:00Z"}},
+					{"id": "agent-2", "type": "agents", "attributes": {"name": "two", "status": "errored", "ip-address": "10.0.0.2", "last-ping-at": "2021-05-01T10:00:00Z"}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 2}}
+			}`))
+		case "GET /api/tfe/v2/agents/agent-1":
+			w.Write([]byte(`{"data": {"id": "agent-1", "type": "agents", "attributes": {"name": "one", "status": "busy", "ip-address": "10.0.0.1", "last-ping-at": "2021-06-01T10:00:00Z"},` +
+				`"relationships": {"agent-pool": {"data": {"id": "apool-123", "type": "agent-pools"}}}}}`))
+		case "DELETE /api/tfe/v2/agents/agent-2":
+			w.WriteHeader(204)
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when listing the agents of a pool", func(t *testing.T) {
+		requests = nil
+
+		since := time.Date(2021, 5, 15, 0, 0, 0, 0, time.UTC)
+		al, err := client.Agents.List(ctx, "apool-123", AgentListOptions{
+			LastPingSince: &since,
+		})
+		require.NoError(t, err)
+		require.Len(t, al.Items, 2)
+		assert.Equal(t, "one", al.Items[0].Name)
+		assert.Equal(t, AgentIdle, al.Items[0].Status)
+		assert.Equal(t, "10.0.0.1", al.Items[0].IP)
+		assert.Equal(t, time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC), al.Items[0].LastPingAt)
+		assert.Equal(t, AgentErrored, al.Items[1].Status)
+		assert.Equal(t, 2, al.TotalCount)
+
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/agent-pools/apool-123/agents?filter%5Blast-ping-since%5D=2021-05-15T00%3A00%3A00Z",
+		}, requests)
+	})
+
+	t.Run("when reading an agent", func(t *testing.T) {
+		a, err := client.Agents.Read(ctx, "agent-1")
+		require.NoError(t, err)
+		assert.Equal(t, AgentBusy, a.Status)
+		require.NotNil(t, a.AgentPool)
+		assert.Equal(t, "apool-123", a.AgentPool.ID)
+	})
+
+	t.Run("when the agent does not exist", func(t *testing.T) {
+		a, err := client.Agents.Read(ctx, "nonexisting")
+		assert.Nil(t, a)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("when deleting an agent", func(t *testing.T) {
+		require.NoError(t, client.Agents.Delete(ctx, "agent-2"))
+	})
+
+	t.Run("without valid IDs", func(t *testing.T) {
+		al, err := client.Agents.List(ctx, badIdentifier, AgentListOptions{})
+		assert.Nil(t, al)
+		assert.EqualError(t, err, "invalid value for agent pool ID")
+
+		a, err := client.Agents.Read(ctx, badIdentifier)
+		assert.Nil(t, a)
+		assert.EqualError(t, err, "invalid value for agent ID")
+
+		err = client.Agents.Delete(ctx, badIdentifier)
+		assert.EqualError(t, err, "invalid value for agent ID")
+	})
+}
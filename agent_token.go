@@ -0,0 +1,163 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ AgentTokens = (*agentTokens)(nil)
+
+// AgentTokens describes all the agent token related methods that the
+// Terraform Enterprise API supports. Agents use these tokens to register
+// with their agent pool.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/agent-tokens.html
+type AgentTokens interface {
+	// List all the agent tokens of the given agent pool.
+	List(ctx context.Context, agentPoolID string, options AgentTokenListOptions) (*AgentTokenList, error)
+
+	// Generate a new agent token for the given agent pool. The token value
+	// is only returned by this call and cannot be read afterwards.
+	Generate(ctx context.Context, agentPoolID string, options AgentTokenGenerateOptions) (*AgentToken, error)
+
+	// Read an agent token by its ID.
+	Read(ctx context.Context, agentTokenID string) (*AgentToken, error)
+
+	// Delete an agent token by its ID.
+	Delete(ctx context.Context, agentTokenID string) error
+}
+
+// agentTokens implements AgentTokens.
+type agentTokens struct {
+	client *Client
+}
+
+// AgentTokenList represents a list of agent tokens.
+type AgentTokenList struct {
+	*Pagination
+	Items []*AgentToken
+}
+
+// AgentToken represents a Terraform Enterprise agent token. Token is only
+// set in the response of Generate, so make sure to store it right away.
+type AgentToken struct {
+	ID          string    `jsonapi:"primary,authentication-tokens"`
+	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
+	Description string    `jsonapi:"attr,description"`
+	LastUsedAt  time.Time `jsonapi:"attr,last-used-at,iso8601"`
+	Token       string    `jsonapi:"attr,token"`
+}
+
+// AgentTokenListOptions represents the options for listing agent tokens.
+type AgentTokenListOptions struct {
+	ListOptions
+}
+
+// List all the agent tokens of the given agent pool.
+func (s *agentTokens) List(ctx context.Context, agentPoolID string, options AgentTokenListOptions) (*AgentTokenList, error) {
+	if !validStringID(&agentPoolID) {
+		return nil, errors.New("invalid value for agent pool ID")
+	}
+
+	u := fmt.Sprintf("agent-pools/%s/authentication-tokens", url.QueryEscape(agentPoolID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	atl := &AgentTokenList{}
+	err = s.client.do(ctx, req, atl)
+	if err != nil {
+		return nil, err
+	}
+
+	return atl, nil
+}
+
+// AgentTokenGenerateOptions represents the options for generating an agent
+// token.
+type AgentTokenGenerateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,authentication-tokens"`
+
+	// A description of the agent token, to tell the tokens apart.
+	Description *string `jsonapi:"attr,description"`
+}
+
+// Validate checks the agent token generate options for errors, without making
+// an API request.
+func (o AgentTokenGenerateOptions) Validate() error {
+	if !validString(o.Description) {
+		return validationError("description is required")
+	}
+	return nil
+}
+
+// Generate a new agent token for the given agent pool. The token value is
+// only returned by this call and cannot be read afterwards.
+func (s *agentTokens) Generate(ctx context.Context, agentPoolID string, options AgentTokenGenerateOptions) (*AgentToken, error) {
+	if !validStringID(&agentPoolID) {
+		return nil, errors.New("invalid value for agent pool ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("agent-pools/%s/authentication-tokens", url.QueryEscape(agentPoolID))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	at := &AgentToken{}
+	err = s.client.do(ctx, req, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return at, nil
+}
+
+// Read an agent token by its ID.
+func (s *agentTokens) Read(ctx context.Context, agentTokenID string) (*AgentToken, error) {
+	if !validStringID(&agentTokenID) {
+		return nil, errors.New("invalid value for agent token ID")
+	}
+
+	u := fmt.Sprintf("authentication-tokens/%s", url.QueryEscape(agentTokenID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	at := &AgentToken{}
+	err = s.client.do(ctx, req, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return at, nil
+}
+
+// Delete an agent token by its ID.
+func (s *agentTokens) Delete(ctx context.Context, agentTokenID string) error {
+	if !validStringID(&agentTokenID) {
+		return errors.New("invalid value for agent token ID")
+	}
+
+	u := fmt.Sprintf("authentication-tokens/%s", url.QueryEscape(agentTokenID))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
@@ -0,0 +1,109 @@
+package tfe
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgentTokens(t *testing.T) {
+	var requests []string
+	var body string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/tfe/v2/agent-pools/apool-123/authentication-tokens":
+			w.Write([]byte(`{
+				"data": [
+					{"id": "at-1", "type": "authentication-tokens", "attributes": {"description": "k8s", "token": null}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 1}}
+			}`))
+		case "POST /api/tfe/v2/agent-pools/apool-123/authentication-tokens":
+			b, _ := ioutil.ReadAll(r.Body)
+			body = string(b)
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "at-2", "type": "authentication-tokens", "attributes": {"description": "k8s", "token": "secret"}}}`))
+		case "GET /api/tfe/v2/authentication-tokens/at-1":
+			w.Write([]byte(`{"data": {"id": "at-1", "type": "authentication-tokens", "attributes": {"description": "k8s", "token": null}}}`))
+		case "DELETE /api/tfe/v2/authentication-tokens/at-1":
+			w.WriteHeader(204)
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when listing the agent tokens of a pool", func(t *testing.T) {
+		requests = nil
+
+		atl, err := client.AgentTokens.List(ctx, "apool-123", AgentTokenListOptions{})
+		require.NoError(t, err)
+		require.Len(t, atl.Items, 1)
+		assert.Equal(t, "k8s", atl.Items[0].Description)
+		assert.Empty(t, atl.Items[0].Token)
+		assert.Equal(t, 1, atl.TotalCount)
+
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/agent-pools/apool-123/authentication-tokens",
+		}, requests)
+	})
+
+	t.Run("when generating an agent token", func(t *testing.T) {
+		at, err := client.AgentTokens.Generate(ctx, "apool-123", AgentTokenGenerateOptions{
+			Description: String("k8s"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "at-2", at.ID)
+		assert.Equal(t, "secret", at.Token)
+		assert.Contains(t, body, `"description":"k8s"`)
+	})
+
+	t.Run("when generating an agent token without a description", func(t *testing.T) {
+		at, err := client.AgentTokens.Generate(ctx, "apool-123", AgentTokenGenerateOptions{})
+		assert.Nil(t, at)
+		assert.EqualError(t, err, "description is required")
+	})
+
+	t.Run("when reading an agent token", func(t *testing.T) {
+		at, err := client.AgentTokens.Read(ctx, "at-1")
+		require.NoError(t, err)
+		assert.Equal(t, "k8s", at.Description)
+		assert.Empty(t, at.Token)
+	})
+
+	t.Run("when the agent token does not exist", func(t *testing.T) {
+		at, err := client.AgentTokens.Read(ctx, "nonexisting")
+		assert.Nil(t, at)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("when deleting an agent token", func(t *testing.T) {
+		require.NoError(t, client.AgentTokens.Delete(ctx, "at-1"))
+	})
+
+	t.Run("without valid IDs", func(t *testing.T) {
+		atl, err := client.AgentTokens.List(ctx, badIdentifier, AgentTokenListOptions{})
+		assert.Nil(t, atl)
+		assert.EqualError(t, err, "invalid value for agent pool ID")
+
+		at, err := client.AgentTokens.Generate(ctx, badIdentifier, AgentTokenGenerateOptions{
+			Description: String("k8s"),
+		})
+		assert.Nil(t, at)
+		assert.EqualError(t, err, "invalid value for agent pool ID")
+
+		at, err = client.AgentTokens.Read(ctx, badIdentifier)
+		assert.Nil(t, at)
+		assert.EqualError(t, err, "invalid value for agent token ID")
+
+		err = client.AgentTokens.Delete(ctx, badIdentifier)
+		assert.EqualError(t, err, "invalid value for agent token ID")
+	})
+}
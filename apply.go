@@ -20,7 +20,9 @@ type Applies interface {
 	// Read an apply by its ID.
 	Read(ctx context.Context, applyID string) (*Apply, error)
 
-	// Logs retrieves the logs of an apply.
+	// Logs retrieves the logs of an apply. Reading blocks until new output
+	// is available or the apply is done. Cancel the context to stop tailing
+	// the logs early.
 	Logs(ctx context.Context, applyID string) (io.Reader, error)
 }
 
@@ -45,7 +47,9 @@ const (
 	ApplyUnreachable ApplyStatus = "unreachable"
 )
 
-// Apply represents a Terraform Enterprise apply.
+// Apply represents a Terraform Enterprise apply. The resource counters of an
+// apply reflect the changes that were actually applied, which differ from the
+// counters of the plan when the apply partially failed.
 type Apply struct {
 	ID                   string                 `jsonapi:"primary,applies"`
 	LogReadURL           string                 `jsonapi:"attr,log-read-url"`
@@ -54,6 +58,9 @@ type Apply struct {
 	ResourceDestructions int                    `jsonapi:"attr,resource-destructions"`
 	Status               ApplyStatus            `jsonapi:"attr,status"`
 	StatusTimestamps     *ApplyStatusTimestamps `jsonapi:"attr,status-timestamps"`
+
+	// Relations
+	StateVersions []*StateVersion `jsonapi:"relation,state-versions"`
 }
 
 // ApplyStatusTimestamps holds the timestamps for individual apply statuses.
@@ -123,10 +130,19 @@ func (s *applies) Logs(ctx context.Context, applyID string) (io.Reader, error) {
 		}
 	}
 
+	refresh := func() (*url.URL, error) {
+		a, err := s.Read(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+		return url.Parse(a.LogReadURL)
+	}
+
 	return &LogReader{
-		client: s.client,
-		ctx:    ctx,
-		done:   done,
-		logURL: u,
+		client:  s.client,
+		ctx:     ctx,
+		done:    done,
+		refresh: refresh,
+		logURL:  u,
 	}, nil
 }
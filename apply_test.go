@@ -3,7 +3,10 @@ package tfe
 import (
 	"context"
 	"io/ioutil"
+	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -63,3 +66,47 @@ func TestAppliesLogs(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestAppliesLogsCancel(t *testing.T) {
+	var logRequests int32
+
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/applies/apply-123":
+			// The apply never finishes, so only canceling stops the reader.
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			w.Write([]byte(`{"data": {"id": "apply-123", "type": "applies", "attributes": {
+				"status": "running", "log-read-url": "http://` + r.Host + `/logs/apply-123"}}}`))
+		case "/logs/apply-123":
+			atomic.AddInt32(&logRequests, 1)
+			if r.URL.Query().Get("offset") == "0" {
+				w.Write([]byte("\x02Applying...\n"))
+			}
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logReader, err := client.Applies.Logs(ctx, "apply-123")
+	require.NoError(t, err)
+
+	buf := make([]byte, 64)
+	n, err := logReader.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "Applying...\n", string(buf[:n]))
+
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err = logReader.Read(buf)
+	assert.Equal(t, context.Canceled, err)
+	assert.True(t, time.Since(start) < 5*time.Second, "reading must stop when canceled")
+
+	// No more chunks are requested once the reader is canceled.
+	requests := atomic.LoadInt32(&logRequests)
+	time.Sleep(time.Second)
+	assert.Equal(t, requests, atomic.LoadInt32(&logRequests))
+}
@@ -0,0 +1,97 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ AssessmentResults = (*assessmentResults)(nil)
+
+// AssessmentResults describes all the assessment result related methods that
+// the Terraform Enterprise API supports.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/assessment-results.html
+type AssessmentResults interface {
+	// List all the assessment results of a workspace.
+	List(ctx context.Context, workspaceID string, options AssessmentResultListOptions) (*AssessmentResultList, error)
+
+	// Read an assessment result by its ID.
+	Read(ctx context.Context, assessmentResultID string) (*AssessmentResult, error)
+}
+
+// assessmentResults implements AssessmentResults.
+type assessmentResults struct {
+	client *Client
+}
+
+// AssessmentResultList represents a list of assessment results.
+type AssessmentResultList struct {
+	*Pagination
+	Items []*AssessmentResult
+}
+
+// AssessmentResult represents the result of a health assessment (drift
+// detection) of a workspace.
+type AssessmentResult struct {
+	ID        string    `jsonapi:"primary,assessment-results"`
+	CreatedAt time.Time `jsonapi:"attr,created-at,iso8601"`
+	Drifted   bool      `jsonapi:"attr,drifted"`
+	ErrorMsg  string    `jsonapi:"attr,error-msg"`
+	Succeeded bool      `jsonapi:"attr,succeeded"`
+
+	// Relations
+	Workspace *Workspace `jsonapi:"relation,workspace"`
+}
+
+// AssessmentResultListOptions represents the options for listing assessment
+// results.
+type AssessmentResultListOptions struct {
+	ListOptions
+}
+
+// List all the assessment results of a workspace, most recent first.
+func (s *assessmentResults) List(ctx context.Context, workspaceID string, options AssessmentResultListOptions) (*AssessmentResultList, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+
+	u := fmt.Sprintf("workspaces/%s/assessment-results", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	arl := &AssessmentResultList{}
+	err = s.client.do(ctx, req, arl)
+	if err != nil {
+		return nil, err
+	}
+
+	return arl, nil
+}
+
+// Read an assessment result by its ID.
+func (s *assessmentResults) Read(ctx context.Context, assessmentResultID string) (*AssessmentResult, error) {
+	if !validStringID(&assessmentResultID) {
+		return nil, errors.New("invalid value for assessment result ID")
+	}
+
+	u := fmt.Sprintf("assessment-results/%s", url.QueryEscape(assessmentResultID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ar := &AssessmentResult{}
+	err = s.client.do(ctx, req, ar)
+	if err != nil {
+		return nil, err
+	}
+
+	return ar, nil
+}
@@ -0,0 +1,61 @@
+package tfe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssessmentResultsList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	t.Run("without list options", func(t *testing.T) {
+		arl, err := client.AssessmentResults.List(ctx, wTest.ID, AssessmentResultListOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, arl.Pagination)
+	})
+
+	t.Run("with list options", func(t *testing.T) {
+		// Request a page number which is out of range. The result should
+		// be successful, but return no results if the paging options are
+		// properly passed along.
+		arl, err := client.AssessmentResults.List(ctx, wTest.ID, AssessmentResultListOptions{
+			ListOptions: ListOptions{
+				PageNumber: 999,
+				PageSize:   100,
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, arl.Items)
+		assert.Equal(t, 999, arl.CurrentPage)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		arl, err := client.AssessmentResults.List(ctx, badIdentifier, AssessmentResultListOptions{})
+		assert.Nil(t, arl)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestAssessmentResultsRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("when the assessment result does not exist", func(t *testing.T) {
+		ar, err := client.AssessmentResults.Read(ctx, "asmtres-nonexisting")
+		assert.Nil(t, ar)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid assessment result ID", func(t *testing.T) {
+		ar, err := client.AssessmentResults.Read(ctx, badIdentifier)
+		assert.Nil(t, ar)
+		assert.EqualError(t, err, "invalid value for assessment result ID")
+	})
+}
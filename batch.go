@@ -0,0 +1,112 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the default number of concurrent reads made by
+// the batch readers.
+const DefaultBatchConcurrency = 10
+
+// BatchReadOptions represents the options for reading resources by their IDs.
+type BatchReadOptions struct {
+	// The maximum number of concurrent reads, which defaults to
+	// DefaultBatchConcurrency. All reads are still subject to the rate limit
+	// of the client.
+	Concurrency int
+}
+
+// BatchError is returned by the batch readers when some of the resources
+// could not be read. The resources that were read successfully are returned
+// alongside the error.
+type BatchError struct {
+	// Errors holds the error of each resource that could not be read,
+	// keyed by the ID of the resource. When the context was canceled, the
+	// resources that were never read hold the context error.
+	Errors map[string]error
+
+	// Err is the context error when the context was canceled before all
+	// resources were read, so the error matches context.Canceled or
+	// context.DeadlineExceeded when using errors.Is.
+	Err error
+}
+
+func (e *BatchError) Error() string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	errs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		errs = append(errs, fmt.Sprintf("%s: %v", id, e.Errors[id]))
+	}
+
+	return fmt.Sprintf("failed to read %d resource(s): %s", len(ids), strings.Join(errs, "; "))
+}
+
+// Unwrap returns the context error, if any.
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// readByIDs calls read for each unique ID using at most the configured number
+// of concurrent calls. It returns a *BatchError holding the error of each ID
+// that failed, including the context error for the IDs that were not read
+// because the context was canceled.
+func readByIDs(ctx context.Context, ids []string, options BatchReadOptions, read func(ctx context.Context, id string) error) error {
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs = make(map[string]error)
+		sem  = make(chan struct{}, concurrency)
+		seen = make(map[string]bool, len(ids))
+	)
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			errs[id] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(id string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := read(ctx, id); err != nil {
+				mu.Lock()
+				errs[id] = err
+				mu.Unlock()
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return &BatchError{Errors: errs, Err: ctx.Err()}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchReadByIDs(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		inFlight    int
+		maxInFlight int
+		requests    int
+	)
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		// Give the other reads the chance to run concurrently.
+		time.Sleep(10 * time.Millisecond)
+
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		switch {
+		case strings.HasSuffix(id, "-missing"):
+			w.WriteHeader(404)
+		case strings.HasPrefix(r.URL.Path, "/api/tfe/v2/workspaces/"):
+			w.Write([]byte(`{"data":{"id":"` + id + `","type":"workspaces"}}`))
+		case strings.HasPrefix(r.URL.Path, "/api/tfe/v2/runs/"):
+			w.Write([]byte(`{"data":{"id":"` + id + `","type":"runs"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("workspaces with bounded concurrency", func(t *testing.T) {
+		requests, maxInFlight = 0, 0
+
+		ids := []string{"ws-1", "ws-2", "ws-3", "ws-4", "ws-5", "ws-1"}
+		ws, err := client.Workspaces.ReadByIDs(ctx, ids, BatchReadOptions{Concurrency: 2})
+		require.NoError(t, err)
+		require.Len(t, ws, 5)
+		assert.Equal(t, "ws-3", ws["ws-3"].ID)
+
+		assert.Equal(t, 5, requests)
+		assert.True(t, maxInFlight <= 2, "expected at most 2 concurrent reads, got: %d", maxInFlight)
+	})
+
+	t.Run("workspaces with partial failures", func(t *testing.T) {
+		ws, err := client.Workspaces.ReadByIDs(ctx, []string{"ws-1", "ws-missing", badIdentifier}, BatchReadOptions{})
+		require.Len(t, ws, 1)
+		assert.Equal(t, "ws-1", ws["ws-1"].ID)
+
+		berr, ok := err.(*BatchError)
+		require.True(t, ok, "expected a *BatchError, got: %v", err)
+		require.Len(t, berr.Errors, 2)
+		assert.Equal(t, ErrResourceNotFound, berr.Errors["ws-missing"])
+		assert.EqualError(t, berr.Errors[badIdentifier], "invalid value for workspace ID")
+	})
+
+	t.Run("runs", func(t *testing.T) {
+		rs, err := client.Runs.ReadByIDs(ctx, []string{"run-1", "run-2", "run-missing"}, BatchReadOptions{})
+		require.Len(t, rs, 2)
+		assert.Equal(t, "run-2", rs["run-2"].ID)
+
+		berr, ok := err.(*BatchError)
+		require.True(t, ok, "expected a *BatchError, got: %v", err)
+		assert.Equal(t, ErrResourceNotFound, berr.Errors["run-missing"])
+		assert.EqualError(t, err, "failed to read 1 resource(s): run-missing: resource not found")
+	})
+
+	t.Run("with a canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		rs, err := client.Runs.ReadByIDs(ctx, []string{"run-1", "run-2"}, BatchReadOptions{})
+		assert.Empty(t, rs)
+		assert.True(t, errors.Is(err, context.Canceled))
+
+		berr, ok := err.(*BatchError)
+		require.True(t, ok, "expected a *BatchError, got: %v", err)
+		require.Len(t, berr.Errors, 2)
+		assert.True(t, errors.Is(berr.Errors["run-2"], context.Canceled))
+	})
+
+	t.Run("when the context is canceled while reading", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		readErr := errors.New("read failed")
+		err := readByIDs(ctx, []string{"ws-1", "ws-2", "ws-3"}, BatchReadOptions{Concurrency: 1}, func(ctx context.Context, id string) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			// Cancel the context after the first read failed.
+			cancel()
+			return readErr
+		})
+		assert.True(t, errors.Is(err, context.Canceled))
+
+		berr, ok := err.(*BatchError)
+		require.True(t, ok, "expected a *BatchError, got: %v", err)
+		assert.Equal(t, map[string]error{
+			"ws-1": readErr,
+			"ws-2": context.Canceled,
+			"ws-3": context.Canceled,
+		}, berr.Errors)
+	})
+}
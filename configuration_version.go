@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/url"
 	"os"
 	"time"
@@ -31,10 +33,22 @@ type ConfigurationVersions interface {
 	// Read a configuration version by its ID.
 	Read(ctx context.Context, cvID string) (*ConfigurationVersion, error)
 
+	// Run returns the run that was created for the configuration version,
+	// searching the most recent runs of the workspace.
+	Run(ctx context.Context, workspaceID string, cvID string) (*Run, error)
+
 	// Upload packages and uploads Terraform configuration files. It requires
 	// the upload URL from a configuration version and the full path to the
 	// configuration files on disk.
 	Upload(ctx context.Context, url string, path string) error
+
+	// UploadFrom streams an already packaged configuration archive (a gzipped
+	// tarball) of the given size to the upload URL of a configuration version.
+	UploadFrom(ctx context.Context, url string, r io.Reader, size int64) error
+
+	// Download retrieves the configuration archive (a gzipped tarball) of a
+	// configuration version.
+	Download(ctx context.Context, cvID string) ([]byte, error)
 }
 
 // configurationVersions implements ConfigurationVersions.
@@ -79,10 +93,33 @@ type ConfigurationVersion struct {
 	Error            string              `jsonapi:"attr,error"`
 	ErrorMessage     string              `jsonapi:"attr,error-message"`
 	Source           ConfigurationSource `jsonapi:"attr,source"`
-	Speculative      bool                `jsonapi:"attr,speculative "`
+	Speculative      bool                `jsonapi:"attr,speculative"`
 	Status           ConfigurationStatus `jsonapi:"attr,status"`
 	StatusTimestamps *CVStatusTimestamps `jsonapi:"attr,status-timestamps"`
 	UploadURL        string              `jsonapi:"attr,upload-url"`
+
+	// Relations
+	IngressAttributes *IngressAttributes `jsonapi:"relation,ingress-attributes"`
+}
+
+// IngressAttributes holds the VCS details of the commit a configuration
+// version was ingressed from.
+type IngressAttributes struct {
+	ID                string `jsonapi:"primary,ingress-attributes"`
+	Branch            string `jsonapi:"attr,branch"`
+	CloneURL          string `jsonapi:"attr,clone-url"`
+	CommitMessage     string `jsonapi:"attr,commit-message"`
+	CommitSHA         string `jsonapi:"attr,commit-sha"`
+	CommitURL         string `jsonapi:"attr,commit-url"`
+	CompareURL        string `jsonapi:"attr,compare-url"`
+	Identifier        string `jsonapi:"attr,identifier"`
+	IsPullRequest     bool   `jsonapi:"attr,is-pull-request"`
+	OnDefaultBranch   bool   `jsonapi:"attr,on-default-branch"`
+	PullRequestNumber int    `jsonapi:"attr,pull-request-number"`
+	PullRequestTitle  string `jsonapi:"attr,pull-request-title"`
+	PullRequestURL    string `jsonapi:"attr,pull-request-url"`
+	SenderUsername    string `jsonapi:"attr,sender-username"`
+	Tag               string `jsonapi:"attr,tag"`
 }
 
 // CVStatusTimestamps holds the timestamps for individual configuration version
@@ -180,6 +217,47 @@ func (s *configurationVersions) Read(ctx context.Context, cvID string) (*Configu
 	return cv, nil
 }
 
+// configurationVersionRunPages is the number of pages of runs that are
+// searched for the run of a configuration version.
+const configurationVersionRunPages = 3
+
+// Run returns the run that was created for the configuration version, e.g.
+// when it was uploaded with auto-queue-runs enabled. The API doesn't link a
+// configuration version to its runs, so the runs of the workspace are
+// searched (most recent first) instead. As the run is created right after the
+// configuration version is uploaded, only the 300 most recent runs are
+// searched, which takes at most 3 requests. If none of them uses the
+// configuration version, ErrResourceNotFound is returned.
+func (s *configurationVersions) Run(ctx context.Context, workspaceID, cvID string) (*Run, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if !validStringID(&cvID) {
+		return nil, errors.New("invalid value for configuration version ID")
+	}
+
+	for pageNumber := 1; pageNumber <= configurationVersionRunPages; pageNumber++ {
+		rl, err := s.client.Runs.List(ctx, workspaceID, RunListOptions{
+			ListOptions: ListOptions{PageNumber: pageNumber, PageSize: 100},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range rl.Items {
+			if r.ConfigurationVersion != nil && r.ConfigurationVersion.ID == cvID {
+				return r, nil
+			}
+		}
+
+		if rl.Pagination == nil || rl.NextPage <= pageNumber {
+			break
+		}
+	}
+
+	return nil, ErrResourceNotFound
+}
+
 // Upload packages and uploads Terraform configuration files. It requires the
 // upload URL from a configuration version and the path to the configuration
 // files on disk.
@@ -206,3 +284,62 @@ func (s *configurationVersions) Upload(ctx context.Context, url, path string) er
 
 	return s.client.do(ctx, req, nil)
 }
+
+// UploadFrom streams an already packaged configuration archive (a gzipped
+// tarball) of the given size to the upload URL of a configuration version.
+// The archive is read directly from r and never buffered in memory. As the
+// reader can't be rewound, the upload is not retried when it fails.
+func (s *configurationVersions) UploadFrom(ctx context.Context, url string, r io.Reader, size int64) error {
+	if r == nil {
+		return errors.New("reader is required")
+	}
+	if size <= 0 {
+		return errors.New("invalid value for size")
+	}
+
+	req, err := s.client.newRequest("PUT", url, nil)
+	if err != nil {
+		return err
+	}
+
+	// Use the underlying HTTP request so the body isn't read into memory.
+	httpReq := req.Request.WithContext(ctx)
+	httpReq.Body = ioutil.NopCloser(r)
+	httpReq.ContentLength = size
+
+	// Wait will block until the limiter can obtain a new token
+	// or returns an error if the given context is canceled.
+	if err := s.client.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	resp, err := s.client.doOnce(httpReq)
+	if err != nil {
+		// If we got an error, and the context has been canceled,
+		// the context's error is probably more useful.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			return err
+		}
+	}
+	defer resp.Body.Close()
+
+	return checkResponseCode(resp)
+}
+
+// Download retrieves the configuration archive (a gzipped tarball) of a
+// configuration version. The API redirects to a pre-signed URL that expires,
+// so the download is requested again for a fresh URL when the download is
+// refused because the URL expired.
+func (s *configurationVersions) Download(ctx context.Context, cvID string) ([]byte, error) {
+	if !validStringID(&cvID) {
+		return nil, errors.New("invalid value for configuration version ID")
+	}
+
+	u := fmt.Sprintf("configuration-versions/%s/download", url.QueryEscape(cvID))
+	return s.client.download(ctx, "application/octet-stream", func() (string, error) {
+		return u, nil
+	})
+}
@@ -1,10 +1,14 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	slug "github.com/hashicorp/go-slug"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -99,6 +103,29 @@ func TestConfigurationVersionsCreate(t *testing.T) {
 		}
 	})
 
+	t.Run("with a speculative configuration version", func(t *testing.T) {
+		cv, err := client.ConfigurationVersions.Create(ctx,
+			wTest.ID,
+			ConfigurationVersionCreateOptions{
+				AutoQueueRuns: Bool(false),
+				Speculative:   Bool(true),
+			},
+		)
+		require.NoError(t, err)
+
+		// Get a refreshed view of the configuration version.
+		refreshed, err := client.ConfigurationVersions.Read(ctx, cv.ID)
+		require.NoError(t, err)
+
+		for _, item := range []*ConfigurationVersion{
+			cv,
+			refreshed,
+		} {
+			assert.False(t, item.AutoQueueRuns)
+			assert.True(t, item.Speculative)
+		}
+	})
+
 	t.Run("with invalid workspace id", func(t *testing.T) {
 		cv, err := client.ConfigurationVersions.Create(
 			ctx,
@@ -142,6 +169,20 @@ func TestConfigurationVersionsRead(t *testing.T) {
 	})
 }
 
+func TestConfigurationVersionsReadSpeculative(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/configuration-versions/cv-123", r.URL.Path)
+		w.Write([]byte(`{"data": {"id": "cv-123", "type": "configuration-versions", "attributes": {
+			"auto-queue-runs": false, "speculative": true, "status": "pending", "upload-url": "https://archivist.example.com/v1/object/123"}}}`))
+	})
+
+	cv, err := client.ConfigurationVersions.Read(context.Background(), "cv-123")
+	require.NoError(t, err)
+	assert.False(t, cv.AutoQueueRuns)
+	assert.True(t, cv.Speculative)
+	assert.Equal(t, "https://archivist.example.com/v1/object/123", cv.UploadURL)
+}
+
 func TestConfigurationVersionsUpload(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -193,3 +234,163 @@ func TestConfigurationVersionsUpload(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestConfigurationVersionsUploadFrom(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	cv, cvCleanup := createConfigurationVersion(t, client, nil)
+	defer cvCleanup()
+
+	archive := bytes.NewBuffer(nil)
+	_, err := slug.Pack("test-fixtures/config-version", archive, true)
+	require.NoError(t, err)
+
+	t.Run("with valid options", func(t *testing.T) {
+		size := int64(archive.Len())
+		err := client.ConfigurationVersions.UploadFrom(ctx, cv.UploadURL, archive, size)
+		require.NoError(t, err)
+
+		// We do this is a small loop, because it can take a second
+		// before the upload is finished.
+		for i := 0; ; i++ {
+			refreshed, err := client.ConfigurationVersions.Read(ctx, cv.ID)
+			require.NoError(t, err)
+
+			if refreshed.Status == ConfigurationUploaded {
+				break
+			}
+
+			if i > 10 {
+				t.Fatal("Timeout waiting for the configuration version to be uploaded")
+			}
+
+			time.Sleep(1 * time.Second)
+		}
+	})
+
+	t.Run("without a reader", func(t *testing.T) {
+		err := client.ConfigurationVersions.UploadFrom(ctx, cv.UploadURL, nil, 10)
+		assert.EqualError(t, err, "reader is required")
+	})
+
+	t.Run("without a valid size", func(t *testing.T) {
+		err := client.ConfigurationVersions.UploadFrom(ctx, cv.UploadURL, bytes.NewReader(nil), 0)
+		assert.EqualError(t, err, "invalid value for size")
+	})
+}
+
+func TestConfigurationVersionsRun(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	rTest, _ := createRun(t, client, wTest)
+
+	t.Run("when a run exists for the configuration version", func(t *testing.T) {
+		require.NotNil(t, rTest.ConfigurationVersion)
+
+		r, err := client.ConfigurationVersions.Run(ctx, wTest.ID, rTest.ConfigurationVersion.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rTest.ID, r.ID)
+	})
+
+	t.Run("when no run exists for the configuration version", func(t *testing.T) {
+		cv, _ := createConfigurationVersion(t, client, wTest)
+
+		r, err := client.ConfigurationVersions.Run(ctx, wTest.ID, cv.ID)
+		assert.Nil(t, r)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid workspace ID", func(t *testing.T) {
+		r, err := client.ConfigurationVersions.Run(ctx, badIdentifier, rTest.ConfigurationVersion.ID)
+		assert.Nil(t, r)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+
+	t.Run("with invalid configuration version ID", func(t *testing.T) {
+		r, err := client.ConfigurationVersions.Run(ctx, wTest.ID, badIdentifier)
+		assert.Nil(t, r)
+		assert.EqualError(t, err, "invalid value for configuration version ID")
+	})
+}
+
+func TestConfigurationVersionsRunPages(t *testing.T) {
+	var pages []int
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		// Every page has a next page and the run of cv-2 is on page 2.
+		var page int
+		fmt.Sscan(r.URL.Query().Get("page[number]"), &page)
+		pages = append(pages, page)
+		fmt.Fprintf(w, `{"data": [{"id": "run-%d", "type": "runs", "relationships": {
+			"configuration-version": {"data": {"id": "cv-%d", "type": "configuration-versions"}}}}],
+			"meta": {"pagination": {"current-page": %d, "next-page": %d, "total-pages": 1000}}}`, page, page, page, page+1)
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the run is on a later page", func(t *testing.T) {
+		pages = nil
+
+		r, err := client.ConfigurationVersions.Run(ctx, "ws-123", "cv-2")
+		require.NoError(t, err)
+		assert.Equal(t, "run-2", r.ID)
+		assert.Equal(t, []int{1, 2}, pages)
+	})
+
+	t.Run("when the run isn't within the most recent runs", func(t *testing.T) {
+		pages = nil
+
+		r, err := client.ConfigurationVersions.Run(ctx, "ws-123", "cv-nonexisting")
+		assert.Nil(t, r)
+		assert.Equal(t, ErrResourceNotFound, err)
+		assert.Equal(t, []int{1, 2, 3}, pages)
+	})
+}
+
+func TestConfigurationVersionsDownload(t *testing.T) {
+	downloads := 0
+
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/configuration-versions/cv-123/download":
+			// Redirect to a fresh pre-signed URL on every request.
+			downloads++
+			http.Redirect(w, r, fmt.Sprintf("/archivist/cv-123?token=%d", downloads), http.StatusFound)
+		case "/archivist/cv-123":
+			// The first URL expired before it was used.
+			if r.URL.Query().Get("token") == "1" {
+				w.WriteHeader(403)
+				return
+			}
+			w.Write([]byte("archive"))
+		default:
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the download URL expired", func(t *testing.T) {
+		archive, err := client.ConfigurationVersions.Download(ctx, "cv-123")
+		require.NoError(t, err)
+		assert.Equal(t, "archive", string(archive))
+		assert.Equal(t, 2, downloads)
+	})
+
+	t.Run("when the configuration version does not exist", func(t *testing.T) {
+		archive, err := client.ConfigurationVersions.Download(ctx, "cv-nonexisting")
+		assert.Nil(t, archive)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid configuration version ID", func(t *testing.T) {
+		archive, err := client.ConfigurationVersions.Download(ctx, badIdentifier)
+		assert.Nil(t, archive)
+		assert.EqualError(t, err, "invalid value for configuration version ID")
+	})
+}
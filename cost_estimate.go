@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/url"
 	"time"
 )
@@ -21,6 +22,9 @@ type CostEstimates interface {
 	// Read a costEstimate by its ID.
 	Read(ctx context.Context, costEstimateID string) (*CostEstimate, error)
 
+	// ReadByRun reads the costEstimate of a run.
+	ReadByRun(ctx context.Context, runID string) (*CostEstimate, error)
+
 	// Logs retrieves the logs of a costEstimate.
 	Logs(ctx context.Context, costEstimateID string) (io.Reader, error)
 }
@@ -65,6 +69,98 @@ type CostEstimateStatusTimestamps struct {
 	QueuedAt   time.Time `json:"queued-at"`
 }
 
+// CostEstimateDelta represents the change of the monthly cost estimated by a
+// cost estimate. The costs are parsed from the decimal strings returned by
+// the API without losing precision.
+type CostEstimateDelta struct {
+	PriorMonthlyCost    *big.Rat
+	ProposedMonthlyCost *big.Rat
+	DeltaMonthlyCost    *big.Rat
+}
+
+// Delta parses the monthly costs of the cost estimate. It returns an error
+// when the cost estimate is not finished, as the costs are only known then.
+func (ce *CostEstimate) Delta() (*CostEstimateDelta, error) {
+	if ce.Status != CostEstimateFinished {
+		return nil, fmt.Errorf("cost estimate is %s", ce.Status)
+	}
+
+	prior, err := parseCost(ce.PriorMonthlyCost)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for prior monthly cost: %v", err)
+	}
+	proposed, err := parseCost(ce.ProposedMonthlyCost)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for proposed monthly cost: %v", err)
+	}
+	delta, err := parseCost(ce.DeltaMonthlyCost)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for delta monthly cost: %v", err)
+	}
+
+	return &CostEstimateDelta{
+		PriorMonthlyCost:    prior,
+		ProposedMonthlyCost: proposed,
+		DeltaMonthlyCost:    delta,
+	}, nil
+}
+
+// Percentage returns the delta relative to the prior monthly cost, e.g. 3.2
+// for an increase of 3.2%. It returns false when the prior monthly cost is
+// zero, as the percentage is undefined then.
+func (d *CostEstimateDelta) Percentage() (float64, bool) {
+	if d.PriorMonthlyCost.Sign() == 0 {
+		return 0, false
+	}
+	p := new(big.Rat).Quo(d.DeltaMonthlyCost, d.PriorMonthlyCost)
+	f, _ := p.Mul(p, big.NewRat(100, 1)).Float64()
+	return f, true
+}
+
+// String formats the delta as a signed amount of dollars per month, followed
+// by the percentage, e.g. "+$142.50/mo (+3.2%)". The percentage is left out
+// when the prior monthly cost is zero.
+func (d *CostEstimateDelta) String() string {
+	s := fmt.Sprintf("%s$%s/mo", costSign(d.DeltaMonthlyCost.Sign()), new(big.Rat).Abs(d.DeltaMonthlyCost).FloatString(2))
+
+	p, ok := d.Percentage()
+	if !ok {
+		return s
+	}
+
+	// Compare the rounded percentage, so a tiny change isn't shown as +0.0%.
+	rounded := fmt.Sprintf("%.1f", p)
+	switch rounded {
+	case "0.0", "-0.0":
+		return s + " (0.0%)"
+	}
+	if p > 0 {
+		rounded = "+" + rounded
+	}
+	return s + " (" + rounded + "%)"
+}
+
+// parseCost parses a monetary amount returned by the API.
+func parseCost(v string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(v)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a number", v)
+	}
+	return r, nil
+}
+
+// costSign returns the sign used to format an amount with the given sign.
+func costSign(sign int) string {
+	switch {
+	case sign > 0:
+		return "+"
+	case sign < 0:
+		return "-"
+	default:
+		return ""
+	}
+}
+
 // Read a costEstimate by its ID.
 func (s *costEstimates) Read(ctx context.Context, costEstimateID string) (*CostEstimate, error) {
 	if !validStringID(&costEstimateID) {
@@ -86,6 +182,27 @@ func (s *costEstimates) Read(ctx context.Context, costEstimateID string) (*CostE
 	return ce, nil
 }
 
+// ReadByRun reads the costEstimate of a run. It returns ErrNoCostEstimate
+// when the run has no cost estimate, which is also the case when cost
+// estimation is not enabled for the organization. Use the CostEstimation
+// entitlement of the organization to tell these apart.
+func (s *costEstimates) ReadByRun(ctx context.Context, runID string) (*CostEstimate, error) {
+	if !validStringID(&runID) {
+		return nil, errors.New("invalid value for run ID")
+	}
+
+	r, err := s.client.Runs.ReadWithOptions(ctx, runID, RunReadOptions{Include: "cost_estimate"})
+	if err != nil {
+		return nil, err
+	}
+
+	if r.CostEstimate == nil {
+		return nil, ErrNoCostEstimate
+	}
+
+	return r.CostEstimate, nil
+}
+
 // Logs retrieves the logs of a costEstimate.
 func (s *costEstimates) Logs(ctx context.Context, costEstimateID string) (io.Reader, error) {
 	if !validStringID(&costEstimateID) {
@@ -2,6 +2,8 @@ package tfe
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -50,3 +52,152 @@ func TestCostEstimatesRead(t *testing.T) {
 		assert.EqualError(t, err, "invalid value for cost estimate ID")
 	})
 }
+
+func TestCostEstimatesReadByRun(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, _ := createWorkspace(t, client, orgTest)
+	rTest, _ := createCostEstimatedRun(t, client, wTest)
+
+	t.Run("when the run has a costEstimate", func(t *testing.T) {
+		ce, err := client.CostEstimates.ReadByRun(ctx, rTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rTest.CostEstimate.ID, ce.ID)
+		assert.Equal(t, CostEstimateFinished, ce.Status)
+	})
+
+	t.Run("when the run does not exist", func(t *testing.T) {
+		ce, err := client.CostEstimates.ReadByRun(ctx, "nonexisting")
+		assert.Nil(t, ce)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid run ID", func(t *testing.T) {
+		ce, err := client.CostEstimates.ReadByRun(ctx, badIdentifier)
+		assert.Nil(t, ce)
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
+
+func TestCostEstimatesReadByRunRequests(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "cost_estimate", r.URL.Query().Get("include"))
+		switch r.URL.Path {
+		case "/api/tfe/v2/runs/run-estimated":
+			w.Write([]byte(`{"data": {"id": "run-estimated", "type": "runs",
+				"relationships": {"cost-estimate": {"data": {"id": "ce-123", "type": "cost-estimates"}}}},
+				"included": [{"id": "ce-123", "type": "cost-estimates", "attributes": {
+					"status": "finished", "matched-resources-count": 3, "unmatched-resources-count": 1,
+					"prior-monthly-cost": "100.00", "proposed-monthly-cost": "142.50", "delta-monthly-cost": "42.50"}}]}`))
+		case "/api/tfe/v2/runs/run-disabled":
+			w.Write([]byte(`{"data": {"id": "run-disabled", "type": "runs",
+				"relationships": {"cost-estimate": {"data": null}}}}`))
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the run has a cost estimate", func(t *testing.T) {
+		ce, err := client.CostEstimates.ReadByRun(ctx, "run-estimated")
+		require.NoError(t, err)
+		assert.Equal(t, "ce-123", ce.ID)
+		assert.Equal(t, CostEstimateFinished, ce.Status)
+		assert.Equal(t, 3, ce.MatchedResourcesCount)
+		assert.Equal(t, 1, ce.UnmatchedResourcesCount)
+		assert.Equal(t, "42.50", ce.DeltaMonthlyCost)
+	})
+
+	t.Run("when the run has no cost estimate", func(t *testing.T) {
+		ce, err := client.CostEstimates.ReadByRun(ctx, "run-disabled")
+		assert.Nil(t, ce)
+		assert.Equal(t, ErrNoCostEstimate, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
+	})
+}
+
+func TestCostEstimateDelta(t *testing.T) {
+	t.Run("with an increased cost", func(t *testing.T) {
+		ce := &CostEstimate{
+			DeltaMonthlyCost:    "142.5",
+			PriorMonthlyCost:    "4453.125",
+			ProposedMonthlyCost: "4595.625",
+			Status:              CostEstimateFinished,
+		}
+
+		d, err := ce.Delta()
+		require.NoError(t, err)
+		assert.Equal(t, "4595.625", d.ProposedMonthlyCost.FloatString(3))
+
+		p, ok := d.Percentage()
+		assert.True(t, ok)
+		assert.InDelta(t, 3.2, p, 0.0001)
+		assert.Equal(t, "+$142.50/mo (+3.2%)", d.String())
+	})
+
+	t.Run("with a decreased cost", func(t *testing.T) {
+		ce := &CostEstimate{
+			DeltaMonthlyCost:    "-0.105",
+			PriorMonthlyCost:    "10.5",
+			ProposedMonthlyCost: "10.395",
+			Status:              CostEstimateFinished,
+		}
+
+		d, err := ce.Delta()
+		require.NoError(t, err)
+		assert.Equal(t, "-$0.11/mo (-1.0%)", d.String())
+	})
+
+	t.Run("with an unchanged cost", func(t *testing.T) {
+		ce := &CostEstimate{
+			DeltaMonthlyCost:    "0.0",
+			PriorMonthlyCost:    "25.0",
+			ProposedMonthlyCost: "25.0",
+			Status:              CostEstimateFinished,
+		}
+
+		d, err := ce.Delta()
+		require.NoError(t, err)
+		assert.Equal(t, "$0.00/mo (0.0%)", d.String())
+	})
+
+	t.Run("without a prior cost", func(t *testing.T) {
+		ce := &CostEstimate{
+			DeltaMonthlyCost:    "12.0",
+			PriorMonthlyCost:    "0.0",
+			ProposedMonthlyCost: "12.0",
+			Status:              CostEstimateFinished,
+		}
+
+		d, err := ce.Delta()
+		require.NoError(t, err)
+
+		_, ok := d.Percentage()
+		assert.False(t, ok)
+		assert.Equal(t, "+$12.00/mo", d.String())
+	})
+
+	t.Run("when the cost estimate is not finished", func(t *testing.T) {
+		ce := &CostEstimate{Status: CostEstimateQueued}
+
+		d, err := ce.Delta()
+		assert.Nil(t, d)
+		assert.EqualError(t, err, "cost estimate is queued")
+	})
+
+	t.Run("with an invalid cost", func(t *testing.T) {
+		ce := &CostEstimate{
+			DeltaMonthlyCost:    "12.0",
+			PriorMonthlyCost:    "n/a",
+			ProposedMonthlyCost: "12.0",
+			Status:              CostEstimateFinished,
+		}
+
+		d, err := ce.Delta()
+		assert.Nil(t, d)
+		assert.EqualError(t, err, `invalid value for prior monthly cost: "n/a" is not a number`)
+	})
+}
@@ -3,9 +3,12 @@ package tfe
 import (
 	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"testing"
@@ -23,6 +26,34 @@ func testClient(t *testing.T) *Client {
 	return client
 }
 
+func createAgentPool(t *testing.T, client *Client, org *Organization) (*AgentPool, func()) {
+	var orgCleanup func()
+
+	if org == nil {
+		org, orgCleanup = createOrganization(t, client)
+	}
+
+	ctx := context.Background()
+	pool, err := client.AgentPools.Create(ctx, org.Name, AgentPoolCreateOptions{
+		Name: String(randomString(t)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pool, func() {
+		if err := client.AgentPools.Delete(ctx, pool.ID); err != nil {
+			t.Errorf("Error destroying agent pool! WARNING: Dangling resources\n"+
+				"may exist! The full error is shown below.\n\n"+
+				"Agent pool: %s\nError: %s", pool.ID, err)
+		}
+
+		if orgCleanup != nil {
+			orgCleanup()
+		}
+	}
+}
+
 func createConfigurationVersion(t *testing.T, client *Client, w *Workspace) (*ConfigurationVersion, func()) {
 	var wCleanup func()
 
@@ -360,6 +391,74 @@ func createOrganizationToken(t *testing.T, client *Client, org *Organization) (*
 	}
 }
 
+func createProject(t *testing.T, client *Client, org *Organization) (*Project, func()) {
+	var orgCleanup func()
+
+	if org == nil {
+		org, orgCleanup = createOrganization(t, client)
+	}
+
+	ctx := context.Background()
+	p, err := client.Projects.Create(ctx, org.Name, ProjectCreateOptions{
+		Name: String(randomString(t)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return p, func() {
+		if err := client.Projects.Delete(ctx, p.ID); err != nil {
+			t.Errorf("Error destroying project! WARNING: Dangling resources\n"+
+				"may exist! The full error is shown below.\n\n"+
+				"Project: %s\nError: %s", p.ID, err)
+		}
+
+		if orgCleanup != nil {
+			orgCleanup()
+		}
+	}
+}
+
+func createRegistryModule(t *testing.T, client *Client, org *Organization) (*RegistryModule, func()) {
+	var orgCleanup func()
+
+	if org == nil {
+		org, orgCleanup = createOrganization(t, client)
+	}
+
+	githubIdentifier := os.Getenv("GITHUB_REGISTRY_MODULE_IDENTIFIER")
+	if githubIdentifier == "" {
+		t.Skip("Export a valid GITHUB_REGISTRY_MODULE_IDENTIFIER before running this test!")
+	}
+
+	otTest, otTestCleanup := createOAuthToken(t, client, org)
+
+	ctx := context.Background()
+	rm, err := client.RegistryModules.CreateWithVCSConnection(ctx, RegistryModuleCreateWithVCSConnectionOptions{
+		VCSRepo: &RegistryModuleVCSRepoOptions{
+			Identifier:   String(githubIdentifier),
+			OAuthTokenID: String(otTest.ID),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rm, func() {
+		if err := client.RegistryModules.Delete(ctx, org.Name, rm.Name); err != nil {
+			t.Errorf("Error destroying registry module! WARNING: Dangling resources\n"+
+				"may exist! The full error is shown below.\n\n"+
+				"Registry Module: %s\nError: %s", rm.Name, err)
+		}
+
+		otTestCleanup()
+
+		if orgCleanup != nil {
+			orgCleanup()
+		}
+	}
+}
+
 func createRun(t *testing.T, client *Client, w *Workspace) (*Run, func()) {
 	var wCleanup func()
 
@@ -504,6 +603,93 @@ func createPlanExport(t *testing.T, client *Client, r *Run) (*PlanExport, func()
 	}
 }
 
+func createAdminTerraformVersion(t *testing.T, client *Client) (*AdminTerraformVersion, func()) {
+	version := fmt.Sprintf("0.0.%s", randomString(t))
+
+	ctx := context.Background()
+	tv, err := client.AdminTerraformVersions.Create(ctx, AdminTerraformVersionCreateOptions{
+		Version: String(version),
+		URL:     String("https://www.hashicorp.com"),
+		Sha:     String(fmt.Sprintf("%x", sha256.Sum256([]byte(version)))),
+		Enabled: Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tv, func() {
+		if err := client.AdminTerraformVersions.Delete(ctx, tv.ID); err != nil {
+			t.Errorf("Error destroying terraform version! WARNING: Dangling resources\n"+
+				"may exist! The full error is shown below.\n\n"+
+				"Terraform version: %s\nError: %s", tv.Version, err)
+		}
+	}
+}
+
+func createRunTask(t *testing.T, client *Client, org *Organization) (*RunTask, func()) {
+	var orgCleanup func()
+
+	if org == nil {
+		org, orgCleanup = createOrganization(t, client)
+	}
+
+	ctx := context.Background()
+	rt, err := client.RunTasks.Create(ctx, org.Name, RunTaskCreateOptions{
+		Name:     String(randomString(t)),
+		URL:      String("https://example.com/run-task"),
+		Category: String("task"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rt, func() {
+		if err := client.RunTasks.Delete(ctx, rt.ID); err != nil {
+			t.Errorf("Error destroying run task! WARNING: Dangling resources\n"+
+				"may exist! The full error is shown below.\n\n"+
+				"Run task: %s\nError: %s", rt.Name, err)
+		}
+
+		if orgCleanup != nil {
+			orgCleanup()
+		}
+	}
+}
+
+func createRunTrigger(t *testing.T, client *Client, w, sourceable *Workspace) (*RunTrigger, func()) {
+	var wCleanup, sourceableCleanup func()
+
+	if w == nil {
+		w, wCleanup = createWorkspace(t, client, nil)
+	}
+	if sourceable == nil {
+		sourceable, sourceableCleanup = createWorkspace(t, client, w.Organization)
+	}
+
+	ctx := context.Background()
+	rt, err := client.RunTriggers.Create(ctx, w.ID, RunTriggerCreateOptions{
+		Sourceable: sourceable,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rt, func() {
+		if err := client.RunTriggers.Delete(ctx, rt.ID); err != nil {
+			t.Errorf("Error destroying run trigger! WARNING: Dangling resources\n"+
+				"may exist! The full error is shown below.\n\n"+
+				"Run trigger: %s\nError: %s", rt.ID, err)
+		}
+
+		if sourceableCleanup != nil {
+			sourceableCleanup()
+		}
+		if wCleanup != nil {
+			wCleanup()
+		}
+	}
+}
+
 func createSSHKey(t *testing.T, client *Client, org *Organization) (*SSHKey, func()) {
 	var orgCleanup func()
 
@@ -783,3 +969,32 @@ func randomString(t *testing.T) string {
 	v := strconv.FormatInt(time.Now().UnixNano(), 10) // replace to nanosecond
 	return v
 }
+
+// testServer starts a test server using the given handler and returns a
+// client for it. The server answers the ping request of NewClient itself and
+// sets the JSON API content type, which the handler can override, for all
+// other requests. The server is closed when the test finishes.
+func testServer(t *testing.T, handler http.HandlerFunc) *Client {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/tfe/v2/ping" {
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		handler(w, r)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
@@ -15,6 +15,7 @@ type LogReader struct {
 	client      *Client
 	ctx         context.Context
 	done        func() (bool, error)
+	refresh     func() (*url.URL, error)
 	logURL      *url.URL
 	offset      int64
 	reads       int
@@ -53,25 +54,28 @@ func (r *LogReader) Read(l []byte) (int, error) {
 }
 
 func (r *LogReader) read(l []byte) (int, error) {
-	// Update the query string.
-	r.logURL.RawQuery = fmt.Sprintf("limit=%d&offset=%d", len(l), r.offset)
-
-	// Create a new request.
-	req, err := http.NewRequest("GET", r.logURL.String(), nil)
+	// Retrieve the next chunk.
+	resp, err := r.fetch(len(l))
 	if err != nil {
 		return 0, err
 	}
-	req = req.WithContext(r.ctx)
 
-	// Attach the default headers.
-	for k, v := range r.client.headers {
-		req.Header[k] = v
-	}
+	// The log URL is pre-signed and expires after some time. So when
+	// we get a 403, fetch a fresh URL from the parent resource and try
+	// again. We only retry once to prevent looping on real 403s.
+	if resp.StatusCode == http.StatusForbidden && r.refresh != nil {
+		resp.Body.Close()
 
-	// Retrieve the next chunk.
-	resp, err := r.client.http.HTTPClient.Do(req)
-	if err != nil {
-		return 0, err
+		u, err := r.refresh()
+		if err != nil {
+			return 0, err
+		}
+		r.logURL = u
+
+		resp, err = r.fetch(len(l))
+		if err != nil {
+			return 0, err
+		}
 	}
 	defer resp.Body.Close()
 
@@ -141,3 +145,34 @@ func (r *LogReader) read(l []byte) (int, error) {
 
 	return written, nil
 }
+
+// fetch requests a chunk of at most limit bytes, starting at the
+// current offset.
+func (r *LogReader) fetch(limit int) (*http.Response, error) {
+	// Update the query string.
+	r.logURL.RawQuery = fmt.Sprintf("limit=%d&offset=%d", limit, r.offset)
+
+	// Create a new request.
+	req, err := http.NewRequest("GET", r.logURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(r.ctx)
+
+	// Attach the default headers.
+	for k, v := range r.client.headers {
+		req.Header[k] = v
+	}
+
+	resp, err := r.client.doOnce(req)
+	if err != nil {
+		// Return the plain context error when the reader is canceled, so
+		// callers can check for it no matter when they canceled.
+		if ctxErr := r.ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -251,3 +251,49 @@ func TestLogReader_withoutEndOfTextMarker(t *testing.T) {
 		t.Fatalf("expected 42 log reads, got %d reads", logReads)
 	}
 }
+
+func TestLogReader_withExpiredURL(t *testing.T) {
+	t.Parallel()
+
+	logReads := 0
+	ts, lr := testLogReader(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/expired":
+			w.WriteHeader(http.StatusForbidden)
+		case "/fresh":
+			logReads++
+			if logReads == 1 {
+				w.Write([]byte("\x02Terraform run started - logs - Terraform run finished\x03"))
+			}
+		}
+	}))
+	defer ts.Close()
+
+	expiredURL, err := url.Parse(ts.URL + "/expired")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lr.logURL = expiredURL
+
+	refreshes := 0
+	lr.refresh = func() (*url.URL, error) {
+		refreshes++
+		return url.Parse(ts.URL + "/fresh")
+	}
+	lr.done = func() (bool, error) {
+		return true, nil
+	}
+
+	logs, err := ioutil.ReadAll(lr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Terraform run started - logs - Terraform run finished"
+	if string(logs) != expected {
+		t.Fatalf("expected %s, got: %s", expected, string(logs))
+	}
+	if refreshes != 1 {
+		t.Fatalf("expected 1 refresh, got %d refreshes", refreshes)
+	}
+}
@@ -0,0 +1,117 @@
+package tfe
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// ResourceMeta holds the resource level meta object of a JSON API resource.
+// The jsonapi package discards this object while decoding, so resources that
+// carry a meta object expose it through a Meta field of this type which is
+// filled after the resource itself is decoded.
+type ResourceMeta map[string]interface{}
+
+var resourceMetaType = reflect.TypeOf(ResourceMeta{})
+
+// rawResource holds the parts of a JSON API resource that the jsonapi
+// package can not decode into a struct.
+type rawResource struct {
+	Attributes    map[string]json.RawMessage `json:"attributes"`
+	Meta          ResourceMeta               `json:"meta"`
+	Relationships map[string]rawRelationship `json:"relationships"`
+}
+
+// rawRelationship holds the data of a relationship without decoding it, so
+// polymorphic relationships can be decoded depending on their type.
+type rawRelationship struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// rawResourceDecoder is implemented by resources that decode parts of the
+// raw resource themselves, like polymorphic relationships.
+type rawResourceDecoder interface {
+	decodeRawResource(raw *rawResource) error
+}
+
+var rawResourceDecoderType = reflect.TypeOf((*rawResourceDecoder)(nil)).Elem()
+
+// resourceMetaField returns the Meta field of the struct pointed to by v, if
+// the struct has a Meta field of type ResourceMeta.
+func resourceMetaField(v reflect.Value) (reflect.Value, bool) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	f := v.FieldByName("Meta")
+	if !f.IsValid() || f.Type() != resourceMetaType || !f.CanSet() {
+		return reflect.Value{}, false
+	}
+
+	return f, true
+}
+
+// needsRawDecode reports if values of type t carry a resource meta object
+// or decode parts of the raw resource themselves.
+func needsRawDecode(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	if reflect.PtrTo(t).Implements(rawResourceDecoderType) {
+		return true
+	}
+
+	f, ok := t.FieldByName("Meta")
+	return ok && f.Type == resourceMetaType
+}
+
+// applyRawResource sets the Meta field of the struct pointed to by v and
+// lets it decode the rest of the raw resource, if it implements
+// rawResourceDecoder.
+func applyRawResource(v reflect.Value, raw *rawResource) error {
+	if f, ok := resourceMetaField(v); ok {
+		f.Set(reflect.ValueOf(raw.Meta))
+	}
+
+	if d, ok := v.Interface().(rawResourceDecoder); ok {
+		return d.decodeRawResource(raw)
+	}
+
+	return nil
+}
+
+// decodeRawResource decodes the parts of the single resource in body that
+// the jsonapi package discards into v.
+func decodeRawResource(body []byte, v interface{}) error {
+	var payload struct {
+		Data rawResource `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return err
+	}
+
+	return applyRawResource(reflect.ValueOf(v), &payload.Data)
+}
+
+// decodeManyRawResources decodes the parts of the list of resources in body
+// that the jsonapi package discards into each of the given items.
+func decodeManyRawResources(body []byte, items reflect.Value) error {
+	var payload struct {
+		Data []rawResource `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return err
+	}
+
+	for i := 0; i < items.Len() && i < len(payload.Data); i++ {
+		if err := applyRawResource(items.Index(i), &payload.Data[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_resourceMeta(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/workspaces/ws-1":
+			w.Write([]byte(`{
+				"data": {
+					"id": "ws-1",
+					"type": "workspaces",
+					"attributes": {"name": "one"},
+					"meta": {"terraform-version-compatible": false}
+				}
+			}`))
+		case "/api/tfe/v2/organizations/acme/workspaces":
+			w.Write([]byte(`{
+				"data": [
+					{"id": "ws-1", "type": "workspaces", "attributes": {"name": "one"}, "meta": {"terraform-version-compatible": false}},
+					{"id": "ws-2", "type": "workspaces", "attributes": {"name": "two"}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 2}}
+			}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when reading a single resource", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByID(ctx, "ws-1")
+		require.NoError(t, err)
+		assert.Equal(t, "one", w.Name)
+		assert.Equal(t, ResourceMeta{"terraform-version-compatible": false}, w.Meta)
+	})
+
+	t.Run("when listing resources", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 2)
+		assert.Equal(t, ResourceMeta{"terraform-version-compatible": false}, wl.Items[0].Meta)
+		assert.Nil(t, wl.Items[1].Meta)
+		assert.Equal(t, 2, wl.TotalCount)
+	})
+}
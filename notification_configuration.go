@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"time"
 )
 
@@ -35,6 +36,10 @@ type NotificationConfigurations interface {
 
 	// Verify a notification configuration by its ID.
 	Verify(ctx context.Context, notificationConfigurationID string) (*NotificationConfiguration, error)
+
+	// Set the notification configurations of a workspace to exactly the
+	// given configurations, creating, updating and deleting as needed.
+	Set(ctx context.Context, workspaceID string, configs []*NotificationConfiguration, options NotificationConfigurationSetOptions) ([]*NotificationConfiguration, error)
 }
 
 // notificationConfigurations implements NotificationConfigurations.
@@ -50,6 +55,10 @@ const (
 	NotificationTriggerApplying       string = "run:applying"
 	NotificationTriggerCompleted      string = "run:completed"
 	NotificationTriggerErrored        string = "run:errored"
+
+	NotificationTriggerAssessmentCheckFailed string = "assessment:check_failure"
+	NotificationTriggerAssessmentDrifted     string = "assessment:drifted"
+	NotificationTriggerAssessmentFailed      string = "assessment:failed"
 )
 
 // NotificationDestinationType represents the destination type of the
@@ -145,18 +154,25 @@ type NotificationConfigurationCreateOptions struct {
 	URL *string `jsonapi:"attr,url"`
 }
 
-func (o NotificationConfigurationCreateOptions) valid() error {
+// Validate checks the notification configuration create options for errors,
+// without making an API request.
+func (o NotificationConfigurationCreateOptions) Validate() error {
 	if o.DestinationType == nil {
-		return errors.New("destination type is required")
+		return validationError("destination type is required")
 	}
 	if o.Enabled == nil {
-		return errors.New("enabled is required")
+		return validationError("enabled is required")
 	}
 	if !validString(o.Name) {
-		return errors.New("name is required")
+		return validationError("name is required")
 	}
 	if !validString(o.URL) {
-		return errors.New("url is required")
+		return validationError("url is required")
+	}
+	for _, t := range o.Triggers {
+		if !validNotificationTrigger(t) {
+			return validationError("invalid value for trigger")
+		}
 	}
 	return nil
 }
@@ -166,7 +182,7 @@ func (s *notificationConfigurations) Create(ctx context.Context, workspaceID str
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -231,11 +247,25 @@ type NotificationConfigurationUpdateOptions struct {
 	URL *string `jsonapi:"attr,url,omitempty"`
 }
 
+// Validate checks the notification configuration update options for errors,
+// without making an API request.
+func (o NotificationConfigurationUpdateOptions) Validate() error {
+	for _, t := range o.Triggers {
+		if !validNotificationTrigger(t) {
+			return validationError("invalid value for trigger")
+		}
+	}
+	return nil
+}
+
 // Updates a notification configuration with the given options.
 func (s *notificationConfigurations) Update(ctx context.Context, notificationConfigurationID string, options NotificationConfigurationUpdateOptions) (*NotificationConfiguration, error) {
 	if !validStringID(&notificationConfigurationID) {
 		return nil, errors.New("invalid value for notification configuration ID")
 	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
@@ -292,3 +322,202 @@ func (s *notificationConfigurations) Verify(ctx context.Context, notificationCon
 
 	return nc, nil
 }
+
+// NotificationConfigurationSetOptions represents the options for setting the
+// notification configurations of a workspace.
+type NotificationConfigurationSetOptions struct {
+	// Verify the enabled notification configurations that were created or
+	// changed by delivering a verification payload to their url.
+	Verify bool
+}
+
+// validNotificationConfigurations validates the desired notification
+// configurations of a workspace, which are matched on their name.
+func validNotificationConfigurations(configs []*NotificationConfiguration) error {
+	seen := make(map[string]bool, len(configs))
+	for _, nc := range configs {
+		if nc == nil {
+			return errors.New("notification configuration is required")
+		}
+		if err := newNotificationConfigurationCreateOptions(nc).Validate(); err != nil {
+			return err
+		}
+		if seen[nc.Name] {
+			return fmt.Errorf("notification configuration %s is defined more than once", nc.Name)
+		}
+		seen[nc.Name] = true
+	}
+	return nil
+}
+
+// Set the notification configurations of a workspace to exactly the given
+// configurations. Existing configurations are matched on their name and
+// destination type: missing configurations are created, changed configurations
+// are updated and all other configurations, including extra configurations
+// with the same name, are deleted. As the destination type of a configuration
+// can't be updated, a configuration with a different destination type is
+// replaced by creating the new configuration before deleting the old one. A
+// configuration with a token is also updated when the API doesn't return the
+// current token to compare it with.
+func (s *notificationConfigurations) Set(ctx context.Context, workspaceID string, configs []*NotificationConfiguration, options NotificationConfigurationSetOptions) ([]*NotificationConfiguration, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if err := validNotificationConfigurations(configs); err != nil {
+		return nil, err
+	}
+
+	// Group the current notification configurations of the workspace by
+	// name, as the API doesn't require the names to be unique.
+	var listed []*NotificationConfiguration
+	current := make(map[string][]*NotificationConfiguration)
+	err := listPages(0, func(pageNumber int) (*Pagination, bool, error) {
+		ncl, err := s.List(ctx, workspaceID, NotificationConfigurationListOptions{
+			ListOptions: ListOptions{PageNumber: pageNumber},
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, nc := range ncl.Items {
+			listed = append(listed, nc)
+			current[nc.Name] = append(current[nc.Name], nc)
+		}
+		return ncl.Pagination, false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	kept := make(map[string]bool)
+	var result []*NotificationConfiguration
+	for _, desired := range configs {
+		// Keep the first configuration with the same name and destination
+		// type, as the destination type can't be updated.
+		var nc *NotificationConfiguration
+		for _, c := range current[desired.Name] {
+			if c.DestinationType == desired.DestinationType {
+				nc = c
+				break
+			}
+		}
+
+		changed := true
+		switch {
+		case nc == nil:
+			created, err := s.Create(ctx, workspaceID, newNotificationConfigurationCreateOptions(desired))
+			if err != nil {
+				return nil, err
+			}
+			nc = created
+		case notificationConfigurationChanged(nc, desired):
+			updated, err := s.Update(ctx, nc.ID, newNotificationConfigurationUpdateOptions(desired))
+			if err != nil {
+				return nil, err
+			}
+			nc = updated
+		default:
+			changed = false
+		}
+
+		if changed && options.Verify && nc.Enabled {
+			verified, err := s.Verify(ctx, nc.ID)
+			if err != nil {
+				return nil, err
+			}
+			nc = verified
+		}
+
+		kept[nc.ID] = true
+		result = append(result, nc)
+	}
+
+	// Delete the notification configurations that are no longer defined,
+	// that were replaced or that have the same name as the kept ones.
+	for _, nc := range listed {
+		if kept[nc.ID] {
+			continue
+		}
+		if err := s.Delete(ctx, nc.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// newNotificationConfigurationCreateOptions returns the options to create the
+// given notification configuration.
+func newNotificationConfigurationCreateOptions(nc *NotificationConfiguration) NotificationConfigurationCreateOptions {
+	options := NotificationConfigurationCreateOptions{
+		DestinationType: &nc.DestinationType,
+		Enabled:         Bool(nc.Enabled),
+		Name:            String(nc.Name),
+		Triggers:        nc.Triggers,
+		URL:             String(nc.URL),
+	}
+	if nc.DestinationType == "" {
+		options.DestinationType = nil
+	}
+	if nc.Token != "" {
+		options.Token = String(nc.Token)
+	}
+	return options
+}
+
+// newNotificationConfigurationUpdateOptions returns the options to update a
+// notification configuration to the given notification configuration.
+func newNotificationConfigurationUpdateOptions(nc *NotificationConfiguration) NotificationConfigurationUpdateOptions {
+	options := NotificationConfigurationUpdateOptions{
+		Enabled:  Bool(nc.Enabled),
+		Name:     String(nc.Name),
+		Triggers: nc.Triggers,
+		URL:      String(nc.URL),
+	}
+	if nc.Token != "" {
+		options.Token = String(nc.Token)
+	}
+	return options
+}
+
+// notificationConfigurationChanged reports whether the current notification
+// configuration differs from the desired notification configuration.
+func notificationConfigurationChanged(current, desired *NotificationConfiguration) bool {
+	if current.Enabled != desired.Enabled || current.URL != desired.URL {
+		return true
+	}
+	if desired.Token != "" && current.Token != desired.Token {
+		return true
+	}
+	if len(current.Triggers) != len(desired.Triggers) {
+		return true
+	}
+
+	a := append([]string(nil), current.Triggers...)
+	b := append([]string(nil), desired.Triggers...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validNotificationTrigger(v string) bool {
+	switch v {
+	case NotificationTriggerCreated,
+		NotificationTriggerPlanning,
+		NotificationTriggerNeedsAttention,
+		NotificationTriggerApplying,
+		NotificationTriggerCompleted,
+		NotificationTriggerErrored,
+		NotificationTriggerAssessmentCheckFailed,
+		NotificationTriggerAssessmentDrifted,
+		NotificationTriggerAssessmentFailed:
+		return true
+	}
+	return false
+}
@@ -2,6 +2,8 @@ package tfe
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -222,3 +224,222 @@ func TestNotificationConfigurationVerify(t *testing.T) {
 		assert.EqualError(t, err, "invalid value for notification configuration ID")
 	})
 }
+
+func TestNotificationConfigurationAssessmentTriggers(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"triggers":["assessment:check_failure","assessment:drifted","assessment:failed"]`)
+		w.WriteHeader(201)
+		w.Write([]byte(`{"data": {"id": "nc-1", "type": "notification-configurations", "attributes": {
+			"name": "drift", "destination-type": "slack", "enabled": true, "url": "https://slack.example.com",
+			"triggers": ["assessment:check_failure", "assessment:drifted", "assessment:failed"]
+		}}}`))
+	})
+
+	triggers := []string{
+		NotificationTriggerAssessmentCheckFailed,
+		NotificationTriggerAssessmentDrifted,
+		NotificationTriggerAssessmentFailed,
+	}
+
+	destinationType := NotificationDestinationTypeSlack
+	nc, err := client.NotificationConfigurations.Create(context.Background(), "ws-123", NotificationConfigurationCreateOptions{
+		DestinationType: &destinationType,
+		Enabled:         Bool(true),
+		Name:            String("drift"),
+		Triggers:        triggers,
+		URL:             String("https://slack.example.com"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, triggers, nc.Triggers)
+}
+
+func TestNotificationConfigurationTriggersValidate(t *testing.T) {
+	t.Run("when creating with an invalid trigger", func(t *testing.T) {
+		options := NotificationConfigurationCreateOptions{
+			DestinationType: NotificationDestination(NotificationDestinationTypeGeneric),
+			Enabled:         Bool(true),
+			Name:            String("alerts"),
+			Triggers:        []string{NotificationTriggerErrored, "run:failed"},
+			URL:             String("https://example.com"),
+		}
+
+		err := options.Validate()
+		assert.EqualError(t, err, "invalid value for trigger")
+	})
+
+	t.Run("when updating with an invalid trigger", func(t *testing.T) {
+		options := NotificationConfigurationUpdateOptions{
+			Triggers: []string{"apply:errored"},
+		}
+
+		err := options.Validate()
+		assert.EqualError(t, err, "invalid value for trigger")
+	})
+
+	t.Run("with valid triggers", func(t *testing.T) {
+		options := NotificationConfigurationUpdateOptions{
+			Triggers: []string{NotificationTriggerCompleted, NotificationTriggerErrored},
+		}
+
+		assert.NoError(t, options.Validate())
+	})
+}
+
+func TestNotificationConfigurationSet(t *testing.T) {
+	const configs = `{
+		"data": [
+			{"id": "nc-1", "type": "notification-configurations", "attributes": {"name": "alerts", "destination-type": "slack", "enabled": true, "url": "https://slack.example.com/old", "triggers": ["run:errored", "run:created"]}},
+			{"id": "nc-2", "type": "notification-configurations", "attributes": {"name": "old", "destination-type": "generic", "enabled": true, "url": "https://example.com/old"}},
+			{"id": "nc-3", "type": "notification-configurations", "attributes": {"name": "hooks", "destination-type": "generic", "enabled": true, "url": "https://example.com/hooks"}}
+		],
+		"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 3}}
+	}`
+
+	var requests []string
+	list := configs
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == "GET":
+			w.Write([]byte(list))
+		case r.Method == "POST" && r.URL.Path == "/api/tfe/v2/workspaces/ws-123/notification-configurations":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Contains(t, string(body), `"name":"hooks"`)
+			assert.Contains(t, string(body), `"destination-type":"slack"`)
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "nc-4", "type": "notification-configurations", "attributes": {"name": "hooks", "destination-type": "slack", "enabled": true, "url": "https://slack.example.com/hooks"}}}`))
+		case r.Method == "POST":
+			w.Write([]byte(`{"data": {"id": "nc-4", "type": "notification-configurations", "attributes": {"name": "hooks", "destination-type": "slack", "enabled": true, "url": "https://slack.example.com/hooks", "delivery-responses": [{"code": 200, "successful": true}]}}}`))
+		case r.Method == "PATCH":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Contains(t, string(body), `"url":"https://slack.example.com/new"`)
+			w.Write([]byte(`{"data": {"id": "nc-1", "type": "notification-configurations", "attributes": {"name": "alerts", "destination-type": "slack", "enabled": false, "url": "https://slack.example.com/new", "triggers": ["run:created", "run:errored"]}}}`))
+		case r.Method == "DELETE":
+			w.WriteHeader(204)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("with configurations to create, update, replace and delete", func(t *testing.T) {
+		requests = nil
+
+		ncs, err := client.NotificationConfigurations.Set(ctx, "ws-123", []*NotificationConfiguration{
+			{
+				Name:            "alerts",
+				DestinationType: NotificationDestinationTypeSlack,
+				Enabled:         false,
+				URL:             "https://slack.example.com/new",
+				Triggers:        []string{NotificationTriggerCreated, NotificationTriggerErrored},
+			},
+			{
+				Name:            "hooks",
+				DestinationType: NotificationDestinationTypeSlack,
+				Enabled:         true,
+				URL:             "https://slack.example.com/hooks",
+			},
+		}, NotificationConfigurationSetOptions{Verify: true})
+		require.NoError(t, err)
+		require.Len(t, ncs, 2)
+		assert.Equal(t, "nc-1", ncs[0].ID)
+		assert.Equal(t, "nc-4", ncs[1].ID)
+		assert.Len(t, ncs[1].DeliveryResponses, 1)
+
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/workspaces/ws-123/notification-configurations",
+			"PATCH /api/tfe/v2/notification-configurations/nc-1",
+			"POST /api/tfe/v2/workspaces/ws-123/notification-configurations",
+			"POST /api/tfe/v2/notification-configurations/nc-4/actions/verify",
+			"DELETE /api/tfe/v2/notification-configurations/nc-2",
+			"DELETE /api/tfe/v2/notification-configurations/nc-3",
+		}, requests)
+	})
+
+	t.Run("with configurations with the same name", func(t *testing.T) {
+		requests = nil
+		list = `{
+			"data": [
+				{"id": "nc-1", "type": "notification-configurations", "attributes": {"name": "hooks", "destination-type": "generic", "enabled": true, "url": "https://example.com/hooks"}},
+				{"id": "nc-2", "type": "notification-configurations", "attributes": {"name": "hooks", "destination-type": "slack", "enabled": true, "url": "https://slack.example.com/hooks"}},
+				{"id": "nc-3", "type": "notification-configurations", "attributes": {"name": "hooks", "destination-type": "slack", "enabled": true, "url": "https://slack.example.com/other"}}
+			],
+			"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 3}}
+		}`
+		defer func() { list = configs }()
+
+		ncs, err := client.NotificationConfigurations.Set(ctx, "ws-123", []*NotificationConfiguration{
+			{
+				Name:            "hooks",
+				DestinationType: NotificationDestinationTypeSlack,
+				Enabled:         true,
+				URL:             "https://slack.example.com/hooks",
+			},
+		}, NotificationConfigurationSetOptions{})
+		require.NoError(t, err)
+		require.Len(t, ncs, 1)
+		assert.Equal(t, "nc-2", ncs[0].ID)
+
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/workspaces/ws-123/notification-configurations",
+			"DELETE /api/tfe/v2/notification-configurations/nc-1",
+			"DELETE /api/tfe/v2/notification-configurations/nc-3",
+		}, requests)
+	})
+
+	t.Run("without any changes", func(t *testing.T) {
+		requests = nil
+
+		ncs, err := client.NotificationConfigurations.Set(ctx, "ws-123", []*NotificationConfiguration{
+			{
+				Name:            "alerts",
+				DestinationType: NotificationDestinationTypeSlack,
+				Enabled:         true,
+				URL:             "https://slack.example.com/old",
+				Triggers:        []string{NotificationTriggerCreated, NotificationTriggerErrored},
+			},
+			{
+				Name:            "old",
+				DestinationType: NotificationDestinationTypeGeneric,
+				Enabled:         true,
+				URL:             "https://example.com/old",
+			},
+			{
+				Name:            "hooks",
+				DestinationType: NotificationDestinationTypeGeneric,
+				Enabled:         true,
+				URL:             "https://example.com/hooks",
+			},
+		}, NotificationConfigurationSetOptions{Verify: true})
+		require.NoError(t, err)
+		assert.Len(t, ncs, 3)
+
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/workspaces/ws-123/notification-configurations",
+		}, requests)
+	})
+
+	t.Run("when a configuration is defined more than once", func(t *testing.T) {
+		ncs, err := client.NotificationConfigurations.Set(ctx, "ws-123", []*NotificationConfiguration{
+			{Name: "alerts", DestinationType: NotificationDestinationTypeSlack, URL: "https://slack.example.com"},
+			{Name: "alerts", DestinationType: NotificationDestinationTypeGeneric, URL: "https://example.com"},
+		}, NotificationConfigurationSetOptions{})
+		assert.Nil(t, ncs)
+		assert.EqualError(t, err, "notification configuration alerts is defined more than once")
+	})
+
+	t.Run("without a url", func(t *testing.T) {
+		ncs, err := client.NotificationConfigurations.Set(ctx, "ws-123", []*NotificationConfiguration{
+			{Name: "alerts", DestinationType: NotificationDestinationTypeSlack},
+		}, NotificationConfigurationSetOptions{})
+		assert.Nil(t, ncs)
+		assert.EqualError(t, err, "url is required")
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		ncs, err := client.NotificationConfigurations.Set(ctx, badIdentifier, nil, NotificationConfigurationSetOptions{})
+		assert.Nil(t, ncs)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
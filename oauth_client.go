@@ -26,8 +26,21 @@ type OAuthClients interface {
 	// Read an OAuth client by its ID.
 	Read(ctx context.Context, oAuthClientID string) (*OAuthClient, error)
 
+	// Update an OAuth client by its ID.
+	Update(ctx context.Context, oAuthClientID string, options OAuthClientUpdateOptions) (*OAuthClient, error)
+
 	// Delete an OAuth client by its ID.
 	Delete(ctx context.Context, oAuthClientID string) error
+
+	// AddProjects adds projects to an OAuth client.
+	AddProjects(ctx context.Context, oAuthClientID string, options OAuthClientAddProjectsOptions) error
+
+	// RemoveProjects removes projects from an OAuth client.
+	RemoveProjects(ctx context.Context, oAuthClientID string, options OAuthClientRemoveProjectsOptions) error
+
+	// SetProjects sets the projects of an OAuth client to exactly the
+	// projects with the given IDs.
+	SetProjects(ctx context.Context, oAuthClientID string, projectIDs []string) (*OAuthClient, error)
 }
 
 // oAuthClients implements OAuthClients.
@@ -70,6 +83,7 @@ type OAuthClient struct {
 	CreatedAt           time.Time           `jsonapi:"attr,created-at,iso8601"`
 	HTTPURL             string              `jsonapi:"attr,http-url"`
 	Key                 string              `jsonapi:"attr,key"`
+	OrganizationScoped  bool                `jsonapi:"attr,organization-scoped"`
 	RSAPublicKey        string              `jsonapi:"attr,rsa-public-key"`
 	ServiceProvider     ServiceProviderType `jsonapi:"attr,service-provider"`
 	ServiceProviderName string              `jsonapi:"attr,service-provider-display-name"`
@@ -77,6 +91,7 @@ type OAuthClient struct {
 	// Relations
 	Organization *Organization `jsonapi:"relation,organization"`
 	OAuthTokens  []*OAuthToken `jsonapi:"relation,oauth-tokens"`
+	Projects     []*Project    `jsonapi:"relation,projects"`
 }
 
 // OAuthClientListOptions represents the options for listing
@@ -87,8 +102,9 @@ type OAuthClientListOptions struct {
 
 // List all the OAuth clients for a given organization.
 func (s *oAuthClients) List(ctx context.Context, organization string, options OAuthClientListOptions) (*OAuthClientList, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/oauth-clients", url.QueryEscape(organization))
@@ -125,33 +141,43 @@ type OAuthClientCreateOptions struct {
 
 	// The VCS provider being connected with.
 	ServiceProvider *ServiceProviderType `jsonapi:"attr,service-provider"`
+
+	// Whether the OAuth client can be used by all the projects of the
+	// organization, or only by the projects it is added to.
+	OrganizationScoped *bool `jsonapi:"attr,organization-scoped,omitempty"`
 }
 
-func (o OAuthClientCreateOptions) valid() error {
+// Validate checks the OAuth client create options for errors, without making an
+// API request.
+func (o OAuthClientCreateOptions) Validate() error {
 	if !validString(o.APIURL) {
-		return errors.New("API URL is required")
+		return validationError("API URL is required")
 	}
 	if !validString(o.HTTPURL) {
-		return errors.New("HTTP URL is required")
+		return validationError("HTTP URL is required")
 	}
 	if !validString(o.OAuthToken) {
-		return errors.New("OAuth token is required")
+		return validationError("OAuth token is required")
 	}
 	if o.ServiceProvider == nil {
-		return errors.New("service provider is required")
+		return validationError("service provider is required")
+	}
+	if !validServiceProvider(*o.ServiceProvider) {
+		return validationError("invalid value for service provider")
 	}
 	if validString(o.PrivateKey) && *o.ServiceProvider != *ServiceProvider(ServiceProviderAzureDevOpsServer) {
-		return errors.New("Private Key can only be present with Azure DevOps Server service provider")
+		return validationError("Private Key can only be present with Azure DevOps Server service provider")
 	}
 	return nil
 }
 
 // Create an OAuth client to connect an organization and a VCS provider.
 func (s *oAuthClients) Create(ctx context.Context, organization string, options OAuthClientCreateOptions) (*OAuthClient, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -208,3 +234,198 @@ func (s *oAuthClients) Delete(ctx context.Context, oAuthClientID string) error {
 
 	return s.client.do(ctx, req, nil)
 }
+
+// OAuthClientUpdateOptions represents the options for updating an OAuth
+// client.
+type OAuthClientUpdateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,oauth-clients"`
+
+	// Whether the OAuth client can be used by all the projects of the
+	// organization, or only by the projects it is added to.
+	OrganizationScoped *bool `jsonapi:"attr,organization-scoped,omitempty"`
+}
+
+// Update an OAuth client by its ID.
+func (s *oAuthClients) Update(ctx context.Context, oAuthClientID string, options OAuthClientUpdateOptions) (*OAuthClient, error) {
+	if !validStringID(&oAuthClientID) {
+		return nil, errors.New("invalid value for OAuth client ID")
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("oauth-clients/%s", url.QueryEscape(oAuthClientID))
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	oc := &OAuthClient{}
+	err = s.client.do(ctx, req, oc)
+	if err != nil {
+		return nil, err
+	}
+
+	return oc, nil
+}
+
+// projectReference is used to reference a project in a relationship.
+type projectReference struct {
+	ID string `jsonapi:"primary,projects"`
+}
+
+// OAuthClientAddProjectsOptions represents the options for adding projects
+// to an OAuth client.
+type OAuthClientAddProjectsOptions struct {
+	// The projects to add to the OAuth client.
+	Projects []*Project
+}
+
+// Validate checks the OAuth client add projects options for errors, without
+// making an API request.
+func (o OAuthClientAddProjectsOptions) Validate() error {
+	return validProjectReferences(o.Projects)
+}
+
+// AddProjects adds projects to an OAuth client. Unless the OAuth client is
+// organization scoped, only the projects it is added to can use it.
+func (s *oAuthClients) AddProjects(ctx context.Context, oAuthClientID string, options OAuthClientAddProjectsOptions) error {
+	if !validStringID(&oAuthClientID) {
+		return errors.New("invalid value for OAuth client ID")
+	}
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	return s.updateProjects(ctx, "POST", oAuthClientID, options.Projects)
+}
+
+// OAuthClientRemoveProjectsOptions represents the options for removing
+// projects from an OAuth client.
+type OAuthClientRemoveProjectsOptions struct {
+	// The projects to remove from the OAuth client.
+	Projects []*Project
+}
+
+// Validate checks the OAuth client remove projects options for errors,
+// without making an API request.
+func (o OAuthClientRemoveProjectsOptions) Validate() error {
+	return validProjectReferences(o.Projects)
+}
+
+// RemoveProjects removes projects from an OAuth client.
+func (s *oAuthClients) RemoveProjects(ctx context.Context, oAuthClientID string, options OAuthClientRemoveProjectsOptions) error {
+	if !validStringID(&oAuthClientID) {
+		return errors.New("invalid value for OAuth client ID")
+	}
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	return s.updateProjects(ctx, "DELETE", oAuthClientID, options.Projects)
+}
+
+func validProjectReferences(projects []*Project) error {
+	if projects == nil {
+		return validationError("projects is required")
+	}
+	if len(projects) == 0 {
+		return validationError("must provide at least one project")
+	}
+	for _, p := range projects {
+		if p == nil || !validStringID(&p.ID) {
+			return validationError("invalid value for project ID")
+		}
+	}
+	return nil
+}
+
+func (s *oAuthClients) updateProjects(ctx context.Context, method, oAuthClientID string, projects []*Project) error {
+	var refs []*projectReference
+	for _, p := range projects {
+		refs = append(refs, &projectReference{ID: p.ID})
+	}
+
+	u := fmt.Sprintf("oauth-clients/%s/relationships/projects", url.QueryEscape(oAuthClientID))
+	req, err := s.client.newRequest(method, u, refs)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// SetProjects sets the projects of an OAuth client to exactly the projects
+// with the given IDs, by adding the missing projects and removing all other
+// projects. An empty list removes all projects. This does not change whether
+// the OAuth client is organization scoped; use Update for that.
+func (s *oAuthClients) SetProjects(ctx context.Context, oAuthClientID string, projectIDs []string) (*OAuthClient, error) {
+	if !validStringID(&oAuthClientID) {
+		return nil, errors.New("invalid value for OAuth client ID")
+	}
+	for _, id := range projectIDs {
+		if !validStringID(&id) {
+			return nil, errors.New("invalid value for project ID")
+		}
+	}
+
+	oc, err := s.Read(ctx, oAuthClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]bool, len(oc.Projects))
+	for _, p := range oc.Projects {
+		current[p.ID] = true
+	}
+
+	var add []*Project
+	desired := make(map[string]bool, len(projectIDs))
+	for _, id := range projectIDs {
+		if !current[id] && !desired[id] {
+			add = append(add, &Project{ID: id})
+		}
+		desired[id] = true
+	}
+
+	var remove []*Project
+	for _, p := range oc.Projects {
+		if !desired[p.ID] {
+			remove = append(remove, &Project{ID: p.ID})
+		}
+	}
+
+	if len(add) == 0 && len(remove) == 0 {
+		return oc, nil
+	}
+	if len(add) > 0 {
+		if err := s.updateProjects(ctx, "POST", oAuthClientID, add); err != nil {
+			return nil, err
+		}
+	}
+	if len(remove) > 0 {
+		if err := s.updateProjects(ctx, "DELETE", oAuthClientID, remove); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.Read(ctx, oAuthClientID)
+}
+
+func validServiceProvider(v ServiceProviderType) bool {
+	switch v {
+	case ServiceProviderAzureDevOpsServer,
+		ServiceProviderAzureDevOpsServices,
+		ServiceProviderBitbucket,
+		ServiceProviderBitbucketServer,
+		ServiceProviderBitbucketServerLegacy,
+		ServiceProviderGithub,
+		ServiceProviderGithubEE,
+		ServiceProviderGitlab,
+		ServiceProviderGitlabCE,
+		ServiceProviderGitlabEE:
+		return true
+	}
+	return false
+}
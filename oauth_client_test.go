@@ -2,6 +2,8 @@ package tfe
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"testing"
 
@@ -199,6 +201,72 @@ func TestOAuthClientsRead(t *testing.T) {
 	})
 }
 
+func TestOAuthClientsSetProjects(t *testing.T) {
+	var requests []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"data": {"id": "oc-123", "type": "oauth-clients", "attributes": {"organization-scoped": false},
+				"relationships": {"projects": {"data": [{"id": "prj-1", "type": "projects"}, {"id": "prj-2", "type": "projects"}]}}}}`))
+		case "PATCH":
+			w.Write([]byte(`{"data": {"id": "oc-123", "type": "oauth-clients", "attributes": {"organization-scoped": false}}}`))
+		default:
+			w.WriteHeader(204)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("with projects to add and remove", func(t *testing.T) {
+		requests = nil
+
+		_, err := client.OAuthClients.SetProjects(ctx, "oc-123", []string{"prj-2", "prj-3"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/oauth-clients/oc-123 ",
+			`POST /api/tfe/v2/oauth-clients/oc-123/relationships/projects {"data":[{"type":"projects","id":"prj-3"}]}` + "\n",
+			`DELETE /api/tfe/v2/oauth-clients/oc-123/relationships/projects {"data":[{"type":"projects","id":"prj-1"}]}` + "\n",
+			"GET /api/tfe/v2/oauth-clients/oc-123 ",
+		}, requests)
+	})
+
+	t.Run("without any changes", func(t *testing.T) {
+		requests = nil
+
+		oc, err := client.OAuthClients.SetProjects(ctx, "oc-123", []string{"prj-1", "prj-2"})
+		require.NoError(t, err)
+		assert.Len(t, oc.Projects, 2)
+		assert.Len(t, requests, 1)
+	})
+
+	t.Run("when restricting to the organization", func(t *testing.T) {
+		requests = nil
+
+		oc, err := client.OAuthClients.Update(ctx, "oc-123", OAuthClientUpdateOptions{
+			OrganizationScoped: Bool(false),
+		})
+		require.NoError(t, err)
+		assert.False(t, oc.OrganizationScoped)
+		require.Len(t, requests, 1)
+		assert.Contains(t, requests[0], `"organization-scoped":false`)
+	})
+
+	t.Run("with an invalid project ID", func(t *testing.T) {
+		oc, err := client.OAuthClients.SetProjects(ctx, "oc-123", []string{badIdentifier})
+		assert.Nil(t, oc)
+		assert.EqualError(t, err, "invalid value for project ID")
+	})
+
+	t.Run("without projects to add", func(t *testing.T) {
+		err := client.OAuthClients.AddProjects(ctx, "oc-123", OAuthClientAddProjectsOptions{})
+		assert.EqualError(t, err, "projects is required")
+	})
+}
+
 func TestOAuthClientsDelete(t *testing.T) {
 	t.Skip("Unsupported resource")
 	client := testClient(t)
@@ -239,7 +307,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGithub),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.Nil(t, err)
 	})
 
@@ -250,7 +318,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGithub),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "API URL is required")
 	})
 
@@ -261,7 +329,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGithub),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "HTTP URL is required")
 	})
 
@@ -272,7 +340,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGithub),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "OAuth token is required")
 	})
 
@@ -283,10 +351,22 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			OAuthToken: String("NOTHING"),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "service provider is required")
 	})
 
+	t.Run("with an invalid service provider", func(t *testing.T) {
+		options := OAuthClientCreateOptions{
+			APIURL:          String("https://api.github.com"),
+			HTTPURL:         String("https://github.com"),
+			OAuthToken:      String("NOTHING"),
+			ServiceProvider: ServiceProvider("svn"),
+		}
+
+		err := options.Validate()
+		assert.EqualError(t, err, "invalid value for service provider")
+	})
+
 	t.Run("without private key and not ado_server options", func(t *testing.T) {
 		options := OAuthClientCreateOptions{
 			APIURL:          String("https://api.github.com"),
@@ -295,7 +375,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			ServiceProvider: ServiceProvider(ServiceProviderGitlabEE),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.Nil(t, err)
 	})
 
@@ -308,7 +388,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			PrivateKey:      String(""),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.Nil(t, err)
 	})
 
@@ -321,7 +401,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			PrivateKey:      String("NOTHING"),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.EqualError(t, err, "Private Key can only be present with Azure DevOps Server service provider")
 	})
 
@@ -334,7 +414,7 @@ func TestOAuthClientsCreateOptionsValid(t *testing.T) {
 			PrivateKey:      String("NOTHING"),
 		}
 
-		err := options.valid()
+		err := options.Validate()
 		assert.Nil(t, err)
 	})
 }
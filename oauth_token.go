@@ -19,6 +19,10 @@ var _ OAuthTokens = (*oAuthTokens)(nil)
 type OAuthTokens interface {
 	// List all the OAuth tokens for a given organization.
 	List(ctx context.Context, organization string, options OAuthTokenListOptions) (*OAuthTokenList, error)
+
+	// ListByClient lists all the OAuth tokens of the given OAuth client.
+	ListByClient(ctx context.Context, oAuthClientID string, options OAuthTokenListOptions) (*OAuthTokenList, error)
+
 	// Read a OAuth token by its ID.
 	Read(ctx context.Context, oAuthTokenID string) (*OAuthToken, error)
 
@@ -61,8 +65,9 @@ type OAuthTokenListOptions struct {
 
 // List all the OAuth tokens for a given organization.
 func (s *oAuthTokens) List(ctx context.Context, organization string, options OAuthTokenListOptions) (*OAuthTokenList, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/oauth-tokens", url.QueryEscape(organization))
@@ -80,6 +85,27 @@ func (s *oAuthTokens) List(ctx context.Context, organization string, options OAu
 	return otl, nil
 }
 
+// ListByClient lists all the OAuth tokens of the given OAuth client.
+func (s *oAuthTokens) ListByClient(ctx context.Context, oAuthClientID string, options OAuthTokenListOptions) (*OAuthTokenList, error) {
+	if !validStringID(&oAuthClientID) {
+		return nil, errors.New("invalid value for OAuth client ID")
+	}
+
+	u := fmt.Sprintf("oauth-clients/%s/oauth-tokens", url.QueryEscape(oAuthClientID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	otl := &OAuthTokenList{}
+	err = s.client.do(ctx, req, otl)
+	if err != nil {
+		return nil, err
+	}
+
+	return otl, nil
+}
+
 // Read an OAuth token by its ID.
 func (s *oAuthTokens) Read(ctx context.Context, oAuthTokenID string) (*OAuthToken, error) {
 	if !validStringID(&oAuthTokenID) {
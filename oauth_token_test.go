@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"net/http"
 	"testing"
 	"time"
 
@@ -75,6 +76,40 @@ func TestOAuthTokensList(t *testing.T) {
 	})
 }
 
+func TestOAuthTokensListByClient(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/oauth-clients/oc-123/oauth-tokens", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("page[number]"))
+		w.Write([]byte(`{"data": [{"id": "ot-123", "type": "oauth-tokens", "attributes": {
+			"has-ssh-key": true, "service-provider-user": "octocat"},
+			"relationships": {"oauth-client": {"data": {"id": "oc-123", "type": "oauth-clients"}}}}],
+			"meta": {"pagination": {"current-page": 2, "total-count": 1}}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with a valid OAuth client ID", func(t *testing.T) {
+		otl, err := client.OAuthTokens.ListByClient(ctx, "oc-123", OAuthTokenListOptions{
+			ListOptions: ListOptions{PageNumber: 2},
+		})
+		require.NoError(t, err)
+		require.Len(t, otl.Items, 1)
+
+		ot := otl.Items[0]
+		assert.Equal(t, "ot-123", ot.ID)
+		assert.True(t, ot.HasSSHKey)
+		assert.Equal(t, "octocat", ot.ServiceProviderUser)
+		require.NotNil(t, ot.OAuthClient)
+		assert.Equal(t, "oc-123", ot.OAuthClient.ID)
+	})
+
+	t.Run("without a valid OAuth client ID", func(t *testing.T) {
+		otl, err := client.OAuthTokens.ListByClient(ctx, badIdentifier, OAuthTokenListOptions{})
+		assert.Nil(t, otl)
+		assert.EqualError(t, err, "invalid value for OAuth client ID")
+	})
+}
+
 func TestOAuthTokensRead(t *testing.T) {
 	t.Skip("Unsupported resource")
 	client := testClient(t)
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -20,12 +22,23 @@ type Organizations interface {
 	// List all the organizations visible to the current user.
 	List(ctx context.Context, options OrganizationListOptions) (*OrganizationList, error)
 
+	// ListAll lists the organizations visible to the current user across
+	// all pages.
+	ListAll(ctx context.Context, options OrganizationListOptions) ([]*Organization, error)
+
 	// Create a new organization with the given options.
 	Create(ctx context.Context, options OrganizationCreateOptions) (*Organization, error)
 
 	// Read an organization by its name.
 	Read(ctx context.Context, organization string) (*Organization, error)
 
+	// ReadWithOptions reads an organization by its name using the given
+	// options.
+	ReadWithOptions(ctx context.Context, organization string, options OrganizationReadOptions) (*Organization, error)
+
+	// Exists reports whether an organization with the given name exists.
+	Exists(ctx context.Context, organization string) (bool, error)
+
 	// Update attributes of an existing organization.
 	Update(ctx context.Context, organization string, options OrganizationUpdateOptions) (*Organization, error)
 
@@ -40,6 +53,12 @@ type Organizations interface {
 
 	// RunQueue shows the current run queue of an organization.
 	RunQueue(ctx context.Context, organization string, options RunQueueOptions) (*RunQueue, error)
+
+	// DefaultProject reads the default project of an organization.
+	DefaultProject(ctx context.Context, organization string) (*Project, error)
+
+	// SetDefaultProject sets the default project of an organization.
+	SetDefaultProject(ctx context.Context, organization string, projectID string) (*Organization, error)
 }
 
 // organizations implements Organizations.
@@ -56,6 +75,14 @@ const (
 	AuthPolicyTwoFactor AuthPolicyType = "two_factor_mandatory"
 )
 
+func validAuthPolicy(v AuthPolicyType) bool {
+	switch v {
+	case AuthPolicyPassword, AuthPolicyTwoFactor:
+		return true
+	}
+	return false
+}
+
 // EnterprisePlanType represents an enterprise plan type.
 type EnterprisePlanType string
 
@@ -67,6 +94,24 @@ const (
 	EnterprisePlanTrial    EnterprisePlanType = "trial"
 )
 
+// ExecutionModeType represents the mode in which runs are executed.
+type ExecutionModeType string
+
+// List of available execution modes.
+const (
+	ExecutionModeAgent  ExecutionModeType = "agent"
+	ExecutionModeLocal  ExecutionModeType = "local"
+	ExecutionModeRemote ExecutionModeType = "remote"
+)
+
+func validExecutionMode(v ExecutionModeType) bool {
+	switch v {
+	case ExecutionModeAgent, ExecutionModeLocal, ExecutionModeRemote:
+		return true
+	}
+	return false
+}
+
 // OrganizationList represents a list of organizations.
 type OrganizationList struct {
 	*Pagination
@@ -75,19 +120,25 @@ type OrganizationList struct {
 
 // Organization represents a Terraform Enterprise organization.
 type Organization struct {
-	Name                   string                   `jsonapi:"primary,organizations"`
-	CollaboratorAuthPolicy AuthPolicyType           `jsonapi:"attr,collaborator-auth-policy"`
-	CostEstimationEnabled  bool                     `jsonapi:"attr,cost-estimation-enabled"`
-	CreatedAt              time.Time                `jsonapi:"attr,created-at,iso8601"`
-	Email                  string                   `jsonapi:"attr,email"`
-	EnterprisePlan         EnterprisePlanType       `jsonapi:"attr,enterprise-plan"`
-	OwnersTeamSAMLRoleID   string                   `jsonapi:"attr,owners-team-saml-role-id"`
-	Permissions            *OrganizationPermissions `jsonapi:"attr,permissions"`
-	SAMLEnabled            bool                     `jsonapi:"attr,saml-enabled"`
-	SessionRemember        int                      `jsonapi:"attr,session-remember"`
-	SessionTimeout         int                      `jsonapi:"attr,session-timeout"`
-	TrialExpiresAt         time.Time                `jsonapi:"attr,trial-expires-at,iso8601"`
-	TwoFactorConformant    bool                     `jsonapi:"attr,two-factor-conformant"`
+	Name                       string                   `jsonapi:"primary,organizations"`
+	AllowMemberTokenManagement bool                     `jsonapi:"attr,allow-member-token-management"`
+	CollaboratorAuthPolicy     AuthPolicyType           `jsonapi:"attr,collaborator-auth-policy"`
+	CostEstimationEnabled      bool                     `jsonapi:"attr,cost-estimation-enabled"`
+	CreatedAt                  time.Time                `jsonapi:"attr,created-at,iso8601"`
+	DefaultExecutionMode       ExecutionModeType        `jsonapi:"attr,default-execution-mode"`
+	Email                      string                   `jsonapi:"attr,email"`
+	EnterprisePlan             EnterprisePlanType       `jsonapi:"attr,enterprise-plan"`
+	OwnersTeamSAMLRoleID       string                   `jsonapi:"attr,owners-team-saml-role-id"`
+	Permissions                *OrganizationPermissions `jsonapi:"attr,permissions"`
+	SAMLEnabled                bool                     `jsonapi:"attr,saml-enabled"`
+	SessionRemember            int                      `jsonapi:"attr,session-remember"`
+	SessionTimeout             int                      `jsonapi:"attr,session-timeout"`
+	TrialExpiresAt             time.Time                `jsonapi:"attr,trial-expires-at,iso8601"`
+	TwoFactorConformant        bool                     `jsonapi:"attr,two-factor-conformant"`
+
+	// Relations
+	DefaultAgentPool *AgentPool `jsonapi:"relation,default-agent-pool"`
+	DefaultProject   *Project   `jsonapi:"relation,default-project"`
 }
 
 // Capacity represents the current run capacity of an organization.
@@ -100,6 +151,8 @@ type Capacity struct {
 // Entitlements represents the entitlements of an organization.
 type Entitlements struct {
 	ID                    string `jsonapi:"primary,entitlement-sets"`
+	Agents                bool   `jsonapi:"attr,agents"`
+	CostEstimation        bool   `jsonapi:"attr,cost-estimation"`
 	Operations            bool   `jsonapi:"attr,operations"`
 	PrivateModuleRegistry bool   `jsonapi:"attr,private-module-registry"`
 	Sentinel              bool   `jsonapi:"attr,sentinel"`
@@ -116,24 +169,140 @@ type RunQueue struct {
 
 // OrganizationPermissions represents the organization permissions.
 type OrganizationPermissions struct {
+	CanCreateModule             bool `json:"can-create-module"`
 	CanCreateTeam               bool `json:"can-create-team"`
 	CanCreateWorkspace          bool `json:"can-create-workspace"`
 	CanCreateWorkspaceMigration bool `json:"can-create-workspace-migration"`
 	CanDestroy                  bool `json:"can-destroy"`
+	CanManageUsers              bool `json:"can-manage-users"`
 	CanTraverse                 bool `json:"can-traverse"`
 	CanUpdate                   bool `json:"can-update"`
+	CanUpdateAgentPools         bool `json:"can-update-agent-pools"`
 	CanUpdateAPIToken           bool `json:"can-update-api-token"`
 	CanUpdateOAuth              bool `json:"can-update-oauth"`
 	CanUpdateSentinel           bool `json:"can-update-sentinel"`
+	CanUpdateSSHKeys            bool `json:"can-update-ssh-keys"`
+}
+
+// The methods below report whether the token used to read the organization
+// has the given permission. They return false when the organization was read
+// without its permissions, so they can be called on any organization.
+
+// CanCreateModule reports whether the token is allowed to publish modules in
+// the private module registry.
+func (o *Organization) CanCreateModule() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanCreateModule
+}
+
+// CanCreateTeam reports whether the token is allowed to create teams.
+func (o *Organization) CanCreateTeam() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanCreateTeam
+}
+
+// CanCreateWorkspace reports whether the token is allowed to create workspaces.
+func (o *Organization) CanCreateWorkspace() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanCreateWorkspace
+}
+
+// CanCreateWorkspaceMigration reports whether the token is allowed to migrate
+// workspaces.
+func (o *Organization) CanCreateWorkspaceMigration() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanCreateWorkspaceMigration
+}
+
+// CanDestroy reports whether the token is allowed to delete the organization.
+func (o *Organization) CanDestroy() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanDestroy
+}
+
+// CanManageUsers reports whether the token is allowed to manage the users of
+// the organization.
+func (o *Organization) CanManageUsers() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanManageUsers
+}
+
+// CanTraverse reports whether the token is allowed to list the contents of the
+// organization.
+func (o *Organization) CanTraverse() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanTraverse
+}
+
+// CanUpdate reports whether the token is allowed to update the settings of the
+// organization.
+func (o *Organization) CanUpdate() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanUpdate
+}
+
+// CanUpdateAgentPools reports whether the token is allowed to manage agent
+// pools.
+func (o *Organization) CanUpdateAgentPools() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanUpdateAgentPools
+}
+
+// CanUpdateAPIToken reports whether the token is allowed to manage the
+// organization token.
+func (o *Organization) CanUpdateAPIToken() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanUpdateAPIToken
+}
+
+// CanUpdateOAuth reports whether the token is allowed to manage the VCS
+// settings.
+func (o *Organization) CanUpdateOAuth() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanUpdateOAuth
+}
+
+// CanUpdateSentinel reports whether the token is allowed to manage policies and
+// policy sets.
+func (o *Organization) CanUpdateSentinel() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanUpdateSentinel
+}
+
+// CanUpdateSSHKeys reports whether the token is allowed to manage SSH keys.
+func (o *Organization) CanUpdateSSHKeys() bool {
+	return o != nil && o.Permissions != nil && o.Permissions.CanUpdateSSHKeys
 }
 
 // OrganizationListOptions represents the options for listing organizations.
 type OrganizationListOptions struct {
 	ListOptions
+
+	// A search query string. Organizations are searchable by name and
+	// notification email.
+	Query *string `url:"q,omitempty"`
+
+	// Only list the organization with the given notification email.
+	Email *string `url:"filter[email],omitempty"`
+
+	// The attribute to sort the results by. Prefix the attribute with a "-"
+	// to sort in descending order, e.g. "-created-at".
+	Sort *string `url:"sort,omitempty"`
+}
+
+// The attributes organizations can be sorted by.
+var organizationSortKeys = map[string]bool{
+	"name":       true,
+	"email":      true,
+	"created-at": true,
+}
+
+// Validate checks the organization list options for errors, without making an
+// API request.
+func (o OrganizationListOptions) Validate() error {
+	if o.Email != nil && !validString(o.Email) {
+		return validationError("invalid value for email")
+	}
+	if o.Sort != nil && !organizationSortKeys[strings.TrimPrefix(*o.Sort, "-")] {
+		return validationError("invalid value for sort")
+	}
+	return nil
 }
 
 // List all the organizations visible to the current user.
 func (s *organizations) List(ctx context.Context, options OrganizationListOptions) (*OrganizationList, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
 	req, err := s.client.newRequest("GET", "organizations", &options)
 	if err != nil {
 		return nil, err
@@ -148,6 +317,26 @@ func (s *organizations) List(ctx context.Context, options OrganizationListOption
 	return orgl, nil
 }
 
+// ListAll lists the organizations visible to the current user across all
+// pages, starting at the page given in the options.
+func (s *organizations) ListAll(ctx context.Context, options OrganizationListOptions) ([]*Organization, error) {
+	var orgs []*Organization
+	err := listPages(options.PageNumber, func(pageNumber int) (*Pagination, bool, error) {
+		options.PageNumber = pageNumber
+		orgl, err := s.List(ctx, options)
+		if err != nil {
+			return nil, false, err
+		}
+		orgs = append(orgs, orgl.Items...)
+		return orgl.Pagination, false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
 // OrganizationCreateOptions represents the options for creating an organization.
 type OrganizationCreateOptions struct {
 	// For internal use only!
@@ -173,24 +362,30 @@ type OrganizationCreateOptions struct {
 
 	// The name of the "owners" team
 	OwnersTeamSAMLRoleID *string `jsonapi:"attr,owners-team-saml-role-id,omitempty"`
+
+	// Whether members can manage their own user tokens. When disabled, only
+	// the owners of the organization can create tokens for its members.
+	AllowMemberTokenManagement *bool `jsonapi:"attr,allow-member-token-management,omitempty"`
 }
 
-func (o OrganizationCreateOptions) valid() error {
+// Validate checks the organization create options for errors, without making an
+// API request.
+func (o OrganizationCreateOptions) Validate() error {
 	if !validString(o.Name) {
-		return errors.New("name is required")
+		return validationError("name is required")
 	}
 	if !validStringID(o.Name) {
-		return errors.New("invalid value for name")
+		return validationError("invalid value for name")
 	}
 	if !validString(o.Email) {
-		return errors.New("email is required")
+		return validationError("email is required")
 	}
 	return nil
 }
 
 // Create a new organization with the given options.
 func (s *organizations) Create(ctx context.Context, options OrganizationCreateOptions) (*Organization, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -213,12 +408,28 @@ func (s *organizations) Create(ctx context.Context, options OrganizationCreateOp
 
 // Read an organization by its name.
 func (s *organizations) Read(ctx context.Context, organization string) (*Organization, error) {
+	return s.ReadWithOptions(ctx, organization, OrganizationReadOptions{})
+}
+
+// OrganizationIncludeDefaultProject includes the default project when reading
+// an organization.
+const OrganizationIncludeDefaultProject = "default_project"
+
+// OrganizationReadOptions represents the options for reading an organization.
+type OrganizationReadOptions struct {
+	// A comma separated list of related resources to include in the
+	// response, e.g. OrganizationIncludeDefaultProject.
+	Include string `url:"include,omitempty"`
+}
+
+// ReadWithOptions reads an organization by its name using the given options.
+func (s *organizations) ReadWithOptions(ctx context.Context, organization string, options OrganizationReadOptions) (*Organization, error) {
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
 
 	u := fmt.Sprintf("organizations/%s", url.QueryEscape(organization))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +443,20 @@ func (s *organizations) Read(ctx context.Context, organization string) (*Organiz
 	return org, nil
 }
 
+// Exists reports whether an organization with the given name exists. An
+// organization that can not be found results in false, any other error is
+// returned.
+func (s *organizations) Exists(ctx context.Context, organization string) (bool, error) {
+	_, err := s.Read(ctx, organization)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // OrganizationUpdateOptions represents the options for updating an organization.
 type OrganizationUpdateOptions struct {
 	// For internal use only!
@@ -257,13 +482,56 @@ type OrganizationUpdateOptions struct {
 
 	// The name of the "owners" team
 	OwnersTeamSAMLRoleID *string `jsonapi:"attr,owners-team-saml-role-id,omitempty"`
+
+	// Whether members can manage their own user tokens. When disabled, only
+	// the owners of the organization can create tokens for its members.
+	AllowMemberTokenManagement *bool `jsonapi:"attr,allow-member-token-management,omitempty"`
+
+	// The default execution mode of new workspaces.
+	DefaultExecutionMode *ExecutionModeType `jsonapi:"attr,default-execution-mode,omitempty"`
+
+	// The default agent pool of new workspaces. This is required when the
+	// default execution mode is agent.
+	DefaultAgentPool *AgentPool `jsonapi:"relation,default-agent-pool,omitempty"`
+
+	// The project new workspaces are created in when no project is given.
+	DefaultProject *Project `jsonapi:"relation,default-project,omitempty"`
+}
+
+// Validate checks the organization update options for errors, without making an
+// API request.
+func (o OrganizationUpdateOptions) Validate() error {
+	if o.Name != nil && !validStringID(o.Name) {
+		return validationError("invalid value for name")
+	}
+	if o.CollaboratorAuthPolicy != nil && !validAuthPolicy(*o.CollaboratorAuthPolicy) {
+		return validationError("invalid value for collaborator auth policy")
+	}
+	if o.DefaultExecutionMode != nil && !validExecutionMode(*o.DefaultExecutionMode) {
+		return validationError("invalid value for default execution mode")
+	}
+	if o.DefaultAgentPool != nil && !validStringID(&o.DefaultAgentPool.ID) {
+		return validationError("invalid value for default agent pool ID")
+	}
+	if o.DefaultProject != nil && !validStringID(&o.DefaultProject.ID) {
+		return validationError("invalid value for default project ID")
+	}
+	if o.DefaultAgentPool != nil && o.DefaultExecutionMode != nil &&
+		*o.DefaultExecutionMode != ExecutionModeAgent {
+		return validationError("default agent pool can only be set when the default execution mode is agent")
+	}
+	return nil
 }
 
-// Update attributes of an existing organization.
+// Update attributes of an existing organization. Only the options that are
+// set are sent, so all other attributes keep their current values.
 func (s *organizations) Update(ctx context.Context, organization string, options OrganizationUpdateOptions) (*Organization, error) {
 	if !validStringID(&organization) {
 		return nil, errors.New("invalid value for organization")
 	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
@@ -283,6 +551,34 @@ func (s *organizations) Update(ctx context.Context, organization string, options
 	return org, nil
 }
 
+// DefaultProject reads the default project of an organization, which is the
+// project new workspaces are created in when no project is given.
+func (s *organizations) DefaultProject(ctx context.Context, organization string) (*Project, error) {
+	org, err := s.ReadWithOptions(ctx, organization, OrganizationReadOptions{
+		Include: OrganizationIncludeDefaultProject,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if org.DefaultProject == nil {
+		return nil, ErrResourceNotFound
+	}
+
+	return org.DefaultProject, nil
+}
+
+// SetDefaultProject sets the default project of an organization.
+func (s *organizations) SetDefaultProject(ctx context.Context, organization string, projectID string) (*Organization, error) {
+	if !validStringID(&projectID) {
+		return nil, errors.New("invalid value for project ID")
+	}
+
+	return s.Update(ctx, organization, OrganizationUpdateOptions{
+		DefaultProject: &Project{ID: projectID},
+	})
+}
+
 // Delete an organization by its name.
 func (s *organizations) Delete(ctx context.Context, organization string) error {
 	if !validStringID(&organization) {
@@ -325,6 +621,10 @@ func (s *organizations) Entitlements(ctx context.Context, organization string) (
 		return nil, errors.New("invalid value for organization")
 	}
 
+	if e, ok := s.client.entitlements.get(organization); ok {
+		return e, nil
+	}
+
 	u := fmt.Sprintf("organizations/%s/entitlement-set", url.QueryEscape(organization))
 	req, err := s.client.newRequest("GET", u, nil)
 	if err != nil {
@@ -337,9 +637,73 @@ func (s *organizations) Entitlements(ctx context.Context, organization string) (
 		return nil, err
 	}
 
+	s.client.entitlements.set(organization, e)
+
 	return e, nil
 }
 
+// entitlementsCache caches the entitlements of organizations for a
+// configurable amount of time. A zero TTL disables the cache.
+type entitlementsCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]entitlementsCacheEntry
+}
+
+type entitlementsCacheEntry struct {
+	entitlements Entitlements
+	expiresAt    time.Time
+}
+
+func newEntitlementsCache(ttl time.Duration) *entitlementsCache {
+	return &entitlementsCache{
+		ttl:     ttl,
+		entries: make(map[string]entitlementsCacheEntry),
+	}
+}
+
+// get returns a copy of the cached entitlements, if not yet expired.
+func (c *entitlementsCache) get(organization string) (*Entitlements, bool) {
+	if c.ttl <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[organization]
+	if !ok || time.Now().After(entry.expiresAt) {
+		delete(c.entries, organization)
+		return nil, false
+	}
+
+	e := entry.entitlements
+	return &e, true
+}
+
+// set stores a copy of the given entitlements.
+func (c *entitlementsCache) set(organization string, e *Entitlements) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[organization] = entitlementsCacheEntry{
+		entitlements: *e,
+		expiresAt:    time.Now().Add(c.ttl),
+	}
+}
+
+// invalidate removes the cached entitlements of an organization.
+func (c *entitlementsCache) invalidate(organization string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, organization)
+}
+
 // RunQueueOptions represents the options for showing the queue.
 type RunQueueOptions struct {
 	ListOptions
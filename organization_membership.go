@@ -30,6 +30,11 @@ type OrganizationMemberships interface {
 
 	// Delete an organization membership by its ID.
 	Delete(ctx context.Context, organizationMembershipID string) error
+
+	// ListNonConformant lists the organization memberships of the given
+	// organization whose user does not conform to the two factor
+	// authentication policy of the organization.
+	ListNonConformant(ctx context.Context, organization string) ([]*OrganizationMembership, error)
 }
 
 // organizationMemberships implements OrganizationMemberships.
@@ -55,6 +60,7 @@ type OrganizationMembershipList struct {
 // OrganizationMembership represents a Terraform Enterprise organization membership.
 type OrganizationMembership struct {
 	ID     string                       `jsonapi:"primary,organization-memberships"`
+	Email  string                       `jsonapi:"attr,email"`
 	Status OrganizationMembershipStatus `jsonapi:"attr,status"`
 
 	// Relations
@@ -72,8 +78,9 @@ type OrganizationMembershipListOptions struct {
 
 // List all the organization memberships of the given organization.
 func (s *organizationMemberships) List(ctx context.Context, organization string, options OrganizationMembershipListOptions) (*OrganizationMembershipList, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/organization-memberships", url.QueryEscape(organization))
@@ -100,19 +107,22 @@ type OrganizationMembershipCreateOptions struct {
 	Email *string `jsonapi:"attr,email"`
 }
 
-func (o OrganizationMembershipCreateOptions) valid() error {
+// Validate checks the organization membership create options for errors,
+// without making an API request.
+func (o OrganizationMembershipCreateOptions) Validate() error {
 	if o.Email == nil {
-		return errors.New("email is required")
+		return validationError("email is required")
 	}
 	return nil
 }
 
 // Create an organization membership with the given options.
 func (s *organizationMemberships) Create(ctx context.Context, organization string, options OrganizationMembershipCreateOptions) (*OrganizationMembership, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -175,3 +185,38 @@ func (s *organizationMemberships) Delete(ctx context.Context, organizationMember
 
 	return s.client.do(ctx, req, nil)
 }
+
+// ListNonConformant lists the organization memberships of the given
+// organization whose user does not conform to the two factor authentication
+// policy of the organization. It pages through all memberships and includes
+// the users, so the two factor status is resolved in the same requests.
+func (s *organizationMemberships) ListNonConformant(ctx context.Context, organization string) ([]*OrganizationMembership, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var nonConformant []*OrganizationMembership
+
+	err = listPages(0, func(pageNumber int) (*Pagination, bool, error) {
+		ml, err := s.List(ctx, organization, OrganizationMembershipListOptions{
+			ListOptions: ListOptions{PageNumber: pageNumber},
+			Include:     "user",
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, m := range ml.Items {
+			if m.User != nil && !m.User.TwoFactorConformant {
+				nonConformant = append(nonConformant, m)
+			}
+		}
+		return ml.Pagination, false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nonConformant, nil
+}
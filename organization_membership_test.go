@@ -3,6 +3,7 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -225,3 +226,49 @@ func TestOrganizationMembershipsDelete(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestOrganizationMembershipsListNonConformant(t *testing.T) {
+	pages := map[string]string{
+		"": `{
+			"data": [
+				{"id": "ou-1", "type": "organization-memberships", "relationships": {"user": {"data": {"id": "user-1", "type": "users"}}}},
+				{"id": "ou-2", "type": "organization-memberships", "relationships": {"user": {"data": {"id": "user-2", "type": "users"}}}}
+			],
+			"included": [
+				{"id": "user-1", "type": "users", "attributes": {"username": "alice", "two-factor-conformant": true}},
+				{"id": "user-2", "type": "users", "attributes": {"username": "bob", "two-factor-conformant": false}}
+			],
+			"meta": {"pagination": {"current-page": 1, "next-page": 2, "total-pages": 2, "total-count": 3}}
+		}`,
+		"2": `{
+			"data": [
+				{"id": "ou-3", "type": "organization-memberships", "relationships": {"user": {"data": {"id": "user-3", "type": "users"}}}}
+			],
+			"included": [
+				{"id": "user-3", "type": "users", "attributes": {"username": "carol", "two-factor-conformant": false}}
+			],
+			"meta": {"pagination": {"current-page": 2, "prev-page": 1, "total-pages": 2, "total-count": 3}}
+		}`,
+	}
+
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "user", r.URL.Query().Get("include"))
+		w.Write([]byte(pages[r.URL.Query().Get("page[number]")]))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with members across pages", func(t *testing.T) {
+		ms, err := client.OrganizationMemberships.ListNonConformant(ctx, "acme")
+		require.NoError(t, err)
+		require.Len(t, ms, 2)
+		assert.Equal(t, "bob", ms[0].User.Username)
+		assert.Equal(t, "carol", ms[1].User.Username)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		ms, err := client.OrganizationMemberships.ListNonConformant(ctx, badIdentifier)
+		assert.Nil(t, ms)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
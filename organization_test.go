@@ -2,6 +2,10 @@ package tfe
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,6 +51,51 @@ func TestOrganizationsList(t *testing.T) {
 	})
 }
 
+func TestOrganizationsListQuery(t *testing.T) {
+	var query url.Values
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"data":[]}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with search, filter and sort options", func(t *testing.T) {
+		_, err := client.Organizations.List(ctx, OrganizationListOptions{
+			Query: String("acme"),
+			Email: String("ops@example.com"),
+			Sort:  String("-created-at"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "acme", query.Get("q"))
+		assert.Equal(t, "ops@example.com", query.Get("filter[email]"))
+		assert.Equal(t, "-created-at", query.Get("sort"))
+	})
+
+	t.Run("without any options", func(t *testing.T) {
+		_, err := client.Organizations.List(ctx, OrganizationListOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, query, "q")
+		assert.NotContains(t, query, "sort")
+	})
+
+	t.Run("with an invalid sort key", func(t *testing.T) {
+		orgl, err := client.Organizations.List(ctx, OrganizationListOptions{
+			Sort: String("-owner"),
+		})
+		assert.Nil(t, orgl)
+		assert.EqualError(t, err, "invalid value for sort")
+	})
+
+	t.Run("with an empty email filter", func(t *testing.T) {
+		orgl, err := client.Organizations.List(ctx, OrganizationListOptions{
+			Email: String(""),
+		})
+		assert.Nil(t, orgl)
+		assert.EqualError(t, err, "invalid value for email")
+	})
+}
+
 func TestOrganizationsCreate(t *testing.T) {
 	t.Skip("Unsupported resource")
 	client := testClient(t)
@@ -68,6 +117,18 @@ func TestOrganizationsCreate(t *testing.T) {
 		assert.Equal(t, *options.Email, org.Email)
 	})
 
+	t.Run("when the name is already taken", func(t *testing.T) {
+		orgTest, orgTestCleanup := createOrganization(t, client)
+		defer orgTestCleanup()
+
+		org, err := client.Organizations.Create(ctx, OrganizationCreateOptions{
+			Name:  String(orgTest.Name),
+			Email: String(randomString(t) + "@tfe.local"),
+		})
+		assert.Nil(t, org)
+		assert.Equal(t, ErrResourceAlreadyExists, err)
+	})
+
 	t.Run("when no email is provided", func(t *testing.T) {
 		org, err := client.Organizations.Create(ctx, OrganizationCreateOptions{
 			Name: String("foo"),
@@ -128,6 +189,74 @@ func TestOrganizationsRead(t *testing.T) {
 	})
 }
 
+func TestOrganizationPermissions(t *testing.T) {
+	t.Run("with permissions", func(t *testing.T) {
+		org := &Organization{
+			Name: "acme",
+			Permissions: &OrganizationPermissions{
+				CanCreateWorkspace: true,
+				CanManageUsers:     true,
+				CanUpdateOAuth:     true,
+			},
+		}
+		assert.True(t, org.CanCreateWorkspace())
+		assert.True(t, org.CanManageUsers())
+		assert.True(t, org.CanUpdateOAuth())
+		assert.False(t, org.CanCreateTeam())
+		assert.False(t, org.CanDestroy())
+	})
+
+	t.Run("without permissions", func(t *testing.T) {
+		org := &Organization{Name: "acme"}
+		assert.False(t, org.CanCreateWorkspace())
+		assert.False(t, org.CanUpdate())
+	})
+
+	t.Run("without an organization", func(t *testing.T) {
+		var org *Organization
+		assert.False(t, org.CanCreateWorkspace())
+	})
+}
+
+func TestOrganizationsExists(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/organizations/existing":
+			w.Write([]byte(`{"data":{"id":"existing","type":"organizations"}}`))
+		case "/api/tfe/v2/organizations/forbidden":
+			w.WriteHeader(401)
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the org exists", func(t *testing.T) {
+		exists, err := client.Organizations.Exists(ctx, "existing")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("when the org does not exist", func(t *testing.T) {
+		exists, err := client.Organizations.Exists(ctx, "nonexisting")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("when the read fails", func(t *testing.T) {
+		exists, err := client.Organizations.Exists(ctx, "forbidden")
+		assert.False(t, exists)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+
+	t.Run("with invalid name", func(t *testing.T) {
+		exists, err := client.Organizations.Exists(ctx, badIdentifier)
+		assert.False(t, exists)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
 func TestOrganizationsUpdate(t *testing.T) {
 	t.Skip("Unsupported resource")
 	client := testClient(t)
@@ -183,6 +312,206 @@ func TestOrganizationsUpdate(t *testing.T) {
 		assert.Equal(t, orgTest.Name, org.Name)
 		assert.Equal(t, orgTest.Email, org.Email)
 	})
+
+	t.Run("with a default agent pool", func(t *testing.T) {
+		orgTest, orgTestCleanup := createOrganization(t, client)
+		defer orgTestCleanup()
+
+		poolTest, _ := createAgentPool(t, client, orgTest)
+
+		org, err := client.Organizations.Update(ctx, orgTest.Name, OrganizationUpdateOptions{
+			DefaultExecutionMode: ExecutionMode(ExecutionModeAgent),
+			DefaultAgentPool:     poolTest,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ExecutionModeAgent, org.DefaultExecutionMode)
+		require.NotNil(t, org.DefaultAgentPool)
+		assert.Equal(t, poolTest.ID, org.DefaultAgentPool.ID)
+	})
+
+	t.Run("with a default agent pool and another execution mode", func(t *testing.T) {
+		org, err := client.Organizations.Update(ctx, "foo", OrganizationUpdateOptions{
+			DefaultExecutionMode: ExecutionMode(ExecutionModeRemote),
+			DefaultAgentPool:     &AgentPool{ID: "apool-123456789"},
+		})
+		assert.Nil(t, org)
+		assert.EqualError(t, err, "default agent pool can only be set when the default execution mode is agent")
+	})
+
+	t.Run("with an invalid default agent pool ID", func(t *testing.T) {
+		org, err := client.Organizations.Update(ctx, "foo", OrganizationUpdateOptions{
+			DefaultAgentPool: &AgentPool{ID: badIdentifier},
+		})
+		assert.Nil(t, org)
+		assert.EqualError(t, err, "invalid value for default agent pool ID")
+	})
+}
+
+func TestOrganizationsUpdateMemberTokenManagement(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/tfe/v2/organizations/acme", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"allow-member-token-management":false`)
+		w.Write([]byte(`{"data": {"id": "acme", "type": "organizations", "attributes": {"allow-member-token-management": false}}}`))
+	})
+
+	org, err := client.Organizations.Update(context.Background(), "acme", OrganizationUpdateOptions{
+		AllowMemberTokenManagement: Bool(false),
+	})
+	require.NoError(t, err)
+	assert.False(t, org.AllowMemberTokenManagement)
+}
+
+func TestOrganizationsUpdateSettings(t *testing.T) {
+	var attributes map[string]interface{}
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Data struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		attributes = payload.Data.Attributes
+
+		w.Write([]byte(`{"data": {"id": "acme", "type": "organizations"}}`))
+	})
+
+	cases := []struct {
+		name    string
+		options OrganizationUpdateOptions
+		want    map[string]interface{}
+	}{
+		{
+			name:    "collaborator auth policy",
+			options: OrganizationUpdateOptions{CollaboratorAuthPolicy: AuthPolicy(AuthPolicyTwoFactor)},
+			want:    map[string]interface{}{"collaborator-auth-policy": "two_factor_mandatory"},
+		},
+		{
+			name:    "session timeout",
+			options: OrganizationUpdateOptions{SessionTimeout: Int(60)},
+			want:    map[string]interface{}{"session-timeout": float64(60)},
+		},
+		{
+			name:    "session remember",
+			options: OrganizationUpdateOptions{SessionRemember: Int(1440)},
+			want:    map[string]interface{}{"session-remember": float64(1440)},
+		},
+		{
+			name:    "enabling cost estimation",
+			options: OrganizationUpdateOptions{CostEstimationEnabled: Bool(true)},
+			want:    map[string]interface{}{"cost-estimation-enabled": true},
+		},
+		{
+			name:    "disabling cost estimation",
+			options: OrganizationUpdateOptions{CostEstimationEnabled: Bool(false)},
+			want:    map[string]interface{}{"cost-estimation-enabled": false},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := client.Organizations.Update(context.Background(), "acme", c.options)
+			require.NoError(t, err)
+
+			// Only the given setting is sent, so the name and all other
+			// settings are preserved.
+			assert.Equal(t, c.want, attributes)
+		})
+	}
+
+	t.Run("with an invalid collaborator auth policy", func(t *testing.T) {
+		org, err := client.Organizations.Update(context.Background(), "acme", OrganizationUpdateOptions{
+			CollaboratorAuthPolicy: AuthPolicy("sso"),
+		})
+		assert.Nil(t, org)
+		assert.EqualError(t, err, "invalid value for collaborator auth policy")
+	})
+
+	t.Run("with an invalid name", func(t *testing.T) {
+		org, err := client.Organizations.Update(context.Background(), "acme", OrganizationUpdateOptions{
+			Name: String(badIdentifier),
+		})
+		assert.Nil(t, org)
+		assert.EqualError(t, err, "invalid value for name")
+	})
+}
+
+func TestOrganizationsListAll(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/organizations", r.URL.Path)
+		switch r.URL.Query().Get("page[number]") {
+		case "":
+			w.Write([]byte(`{"data": [{"id": "acme", "type": "organizations"}],
+				"meta": {"pagination": {"current-page": 1, "next-page": 2, "total-pages": 2, "total-count": 2}}}`))
+		case "2":
+			w.Write([]byte(`{"data": [{"id": "initech", "type": "organizations"}],
+				"meta": {"pagination": {"current-page": 2, "prev-page": 1, "total-pages": 2, "total-count": 2}}}`))
+		}
+	})
+
+	orgs, err := client.Organizations.ListAll(context.Background(), OrganizationListOptions{})
+	require.NoError(t, err)
+	require.Len(t, orgs, 2)
+	assert.Equal(t, "acme", orgs[0].Name)
+	assert.Equal(t, "initech", orgs[1].Name)
+}
+
+func TestOrganizationsDefaultProject(t *testing.T) {
+	var body string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/organizations/acme":
+			if r.Method == "PATCH" {
+				b, _ := ioutil.ReadAll(r.Body)
+				body = string(b)
+			} else {
+				assert.Equal(t, "default_project", r.URL.Query().Get("include"))
+			}
+			w.Write([]byte(`{"data": {"id": "acme", "type": "organizations",
+				"relationships": {"default-project": {"data": {"id": "prj-123", "type": "projects"}}}},
+				"included": [{"id": "prj-123", "type": "projects", "attributes": {"name": "Unassigned"}}]}`))
+		case "/api/tfe/v2/organizations/legacy":
+			w.Write([]byte(`{"data": {"id": "legacy", "type": "organizations"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when reading the default project", func(t *testing.T) {
+		prj, err := client.Organizations.DefaultProject(ctx, "acme")
+		require.NoError(t, err)
+		assert.Equal(t, "prj-123", prj.ID)
+		assert.Equal(t, "Unassigned", prj.Name)
+	})
+
+	t.Run("when the organization has no default project", func(t *testing.T) {
+		prj, err := client.Organizations.DefaultProject(ctx, "legacy")
+		assert.Nil(t, prj)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("when setting the default project", func(t *testing.T) {
+		org, err := client.Organizations.SetDefaultProject(ctx, "acme", "prj-123")
+		require.NoError(t, err)
+		require.NotNil(t, org.DefaultProject)
+		assert.Equal(t, "prj-123", org.DefaultProject.ID)
+		assert.Contains(t, body, `"default-project":{"data":{"type":"projects","id":"prj-123"}}`)
+	})
+
+	t.Run("with an invalid project ID", func(t *testing.T) {
+		org, err := client.Organizations.SetDefaultProject(ctx, "acme", badIdentifier)
+		assert.Nil(t, org)
+		assert.EqualError(t, err, "invalid value for project ID")
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		prj, err := client.Organizations.DefaultProject(ctx, badIdentifier)
+		assert.Nil(t, prj)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
 }
 
 func TestOrganizationsDelete(t *testing.T) {
@@ -286,6 +615,27 @@ func TestOrganizationsEntitlements(t *testing.T) {
 	})
 }
 
+func TestOrganizationsEntitlementsRequest(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/organizations/acme/entitlement-set", r.URL.Path)
+		w.Write([]byte(`{"data": {"id": "org-acme", "type": "entitlement-sets", "attributes": {
+			"agents": true, "cost-estimation": true, "operations": true, "private-module-registry": false,
+			"sentinel": false, "state-storage": true, "teams": true, "vcs-integrations": true}}}`))
+	})
+
+	entitlements, err := client.Organizations.Entitlements(context.Background(), "acme")
+	require.NoError(t, err)
+	assert.Equal(t, &Entitlements{
+		ID:              "org-acme",
+		Agents:          true,
+		CostEstimation:  true,
+		Operations:      true,
+		StateStorage:    true,
+		Teams:           true,
+		VCSIntegrations: true,
+	}, entitlements)
+}
+
 func TestOrganizationsRunQueue(t *testing.T) {
 	t.Skip("Unsupported resource")
 	client := testClient(t)
@@ -2,7 +2,6 @@ package tfe
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/url"
 	"time"
@@ -17,7 +16,9 @@ var _ OrganizationTokens = (*organizationTokens)(nil)
 // TFE API docs:
 // https://www.terraform.io/docs/enterprise/api/organization-tokens.html
 type OrganizationTokens interface {
-	// Generate a new organization token, replacing any existing token.
+	// Generate a new organization token, replacing any existing token. The
+	// previous token is invalidated immediately, so anything still using it
+	// will fail to authenticate.
 	Generate(ctx context.Context, organization string) (*OrganizationToken, error)
 
 	// Read an organization token.
@@ -33,6 +34,8 @@ type organizationTokens struct {
 }
 
 // OrganizationToken represents a Terraform Enterprise organization token.
+// The Token value is only returned when the token is generated; reading
+// the token afterwards leaves it empty.
 type OrganizationToken struct {
 	ID          string    `jsonapi:"primary,authentication-tokens"`
 	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
@@ -41,10 +44,12 @@ type OrganizationToken struct {
 	Token       string    `jsonapi:"attr,token"`
 }
 
-// Generate a new organization token, replacing any existing token.
+// Generate a new organization token, replacing any existing token. The
+// previous token is invalidated immediately.
 func (s *organizationTokens) Generate(ctx context.Context, organization string) (*OrganizationToken, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/authentication-token", url.QueryEscape(organization))
@@ -64,8 +69,9 @@ func (s *organizationTokens) Generate(ctx context.Context, organization string)
 
 // Read an organization token.
 func (s *organizationTokens) Read(ctx context.Context, organization string) (*OrganizationToken, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/authentication-token", url.QueryEscape(organization))
@@ -85,8 +91,9 @@ func (s *organizationTokens) Read(ctx context.Context, organization string) (*Or
 
 // Delete an organization token.
 func (s *organizationTokens) Delete(ctx context.Context, organization string) error {
-	if !validStringID(&organization) {
-		return errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return err
 	}
 
 	u := fmt.Sprintf("organizations/%s/authentication-token", url.QueryEscape(organization))
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -94,3 +95,33 @@ func TestOrganizationTokensDelete(t *testing.T) {
 		assert.EqualError(t, err, "invalid value for organization")
 	})
 }
+
+func TestOrganizationTokensRequests(t *testing.T) {
+	var methods []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/organizations/my-org/authentication-token", r.URL.Path)
+		methods = append(methods, r.Method)
+
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "at-123", "type": "authentication-tokens", "attributes": {
+				"created-at": "2019-03-06T12:00:00.000Z", "token": "secret-token"}}}`))
+		case "DELETE":
+			w.WriteHeader(204)
+		}
+	})
+
+	ctx := context.Background()
+
+	ot, err := client.OrganizationTokens.Generate(ctx, "my-org")
+	require.NoError(t, err)
+	assert.Equal(t, "at-123", ot.ID)
+	assert.Equal(t, "secret-token", ot.Token)
+	assert.False(t, ot.CreatedAt.IsZero())
+
+	err = client.OrganizationTokens.Delete(ctx, "my-org")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"POST", "DELETE"}, methods)
+}
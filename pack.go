@@ -0,0 +1,260 @@
+package tfe
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/scanner"
+
+	slug "github.com/hashicorp/go-slug"
+)
+
+// PackContents packs the contents of the given directory into a gzipped
+// tarball, suitable as a configuration version archive. The archive is
+// written while it is read from the returned reader, so it is never buffered
+// in memory as a whole. Close the reader when done with it, so packing stops
+// when the archive isn't read to the end.
+//
+// Files matching the rules of a .terraformignore file in the directory are
+// left out, as are the .git and .terraform directories (except for
+// .terraform/modules) by default. File modes are preserved. Symlinks with a
+// target within the directory are kept as symlinks, while symlinks with a
+// target outside of the directory result in an error. A .terraformignore
+// file that go-slug fails to parse, like one with a line of only whitespace,
+// results in an error when reading the archive.
+func PackContents(dir string) (io.ReadCloser, error) {
+	root, err := packRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSymlinks(root); err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		// go-slug panics on some .terraformignore lines, like a line of
+		// only whitespace, so report a panic as an error of the reader.
+		defer func() {
+			if r := recover(); r != nil {
+				pw.CloseWithError(fmt.Errorf("failed to pack %s: %v", dir, r))
+			}
+		}()
+		_, err := slug.Pack(root, pw, false)
+		pw.CloseWithError(err)
+	}()
+
+	return pr, nil
+}
+
+// packRoot returns the absolute path of the directory to pack, with all
+// symlinks resolved, so the targets of symlinks can be compared with it.
+func packRoot(dir string) (string, error) {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	root, err = filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Stat(root)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", dir)
+	}
+
+	return root, nil
+}
+
+// checkSymlinks walks the directory and returns an error for the first
+// symlink with a target outside of the directory. Paths that are not packed
+// because of the ignore rules are skipped. Like go-slug, the walk doesn't
+// skip ignored directories, as a later rule can include paths within them.
+func checkSymlinks(root string) error {
+	rules := parseIgnoreRules(root)
+
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.Mode()&os.ModeSymlink == 0 {
+			return nil
+		}
+
+		subpath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if matchIgnoreRules(subpath, rules) {
+			return nil
+		}
+
+		target, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			return fmt.Errorf("failed to resolve symlink %s: %v", path, err)
+		}
+		if target != root && !strings.HasPrefix(target, root+string(filepath.Separator)) {
+			return fmt.Errorf("symlink %s has a target outside of %s", path, root)
+		}
+
+		return nil
+	})
+}
+
+// The ignore rules below mirror the .terraformignore handling of go-slug,
+// which doesn't export it, so checkSymlinks skips exactly the paths that
+// slug.Pack leaves out.
+
+// ignoreRule represents a single rule of a .terraformignore file.
+type ignoreRule struct {
+	pattern  string
+	excluded bool
+	regex    *regexp.Regexp
+}
+
+// defaultIgnoreRules are the rules that always apply, as they would appear in
+// a .terraformignore file:
+//
+//	.git/
+//	.terraform/
+//	!.terraform/modules/
+func defaultIgnoreRules() []*ignoreRule {
+	sep := string(os.PathSeparator)
+	return []*ignoreRule{
+		{pattern: strings.Join([]string{"**", ".git", "**"}, sep)},
+		{pattern: strings.Join([]string{"**", ".terraform", "**"}, sep)},
+		{pattern: strings.Join([]string{"**", ".terraform", "modules", "**"}, sep), excluded: true},
+	}
+}
+
+// parseIgnoreRules returns the default rules followed by the rules of the
+// .terraformignore file in the root. Like go-slug, it falls back to the
+// default rules when the file can't be read.
+func parseIgnoreRules(root string) []*ignoreRule {
+	rules := defaultIgnoreRules()
+
+	f, err := os.Open(filepath.Join(root, ".terraformignore"))
+	if err != nil {
+		return rules
+	}
+	defer f.Close()
+
+	sep := string(os.PathSeparator)
+	scan := bufio.NewScanner(f)
+	for scan.Scan() {
+		// Skip blank lines, including lines of only whitespace, and comments.
+		pattern := strings.TrimSpace(scan.Text())
+		if pattern == "" || pattern[0] == '#' {
+			continue
+		}
+
+		rule := &ignoreRule{}
+		if pattern[0] == '!' {
+			rule.excluded = true
+			pattern = pattern[1:]
+		}
+		// A directory includes all of its descendants.
+		if strings.HasSuffix(pattern, sep) {
+			pattern += "**"
+		}
+		// A pattern is relative to the root when it starts with a separator,
+		// otherwise it matches at any depth.
+		if strings.HasPrefix(pattern, sep) {
+			pattern = pattern[1:]
+		} else {
+			pattern = "**" + sep + pattern
+		}
+
+		rule.pattern = pattern
+		rules = append(rules, rule)
+	}
+	if scan.Err() != nil {
+		return defaultIgnoreRules()
+	}
+
+	return rules
+}
+
+// matchIgnoreRules reports whether the path, relative to the root, is
+// ignored. The last matching rule wins.
+func matchIgnoreRules(path string, rules []*ignoreRule) bool {
+	matched := false
+	for _, rule := range rules {
+		if rule.match(filepath.FromSlash(path)) {
+			matched = !rule.excluded
+		}
+	}
+	return matched
+}
+
+// match reports whether the rule matches the path. A rule with an invalid
+// pattern never matches.
+func (r *ignoreRule) match(path string) bool {
+	if r.regex == nil {
+		re, err := regexp.Compile(ignorePatternToRegexp(r.pattern))
+		if err != nil {
+			return false
+		}
+		r.regex = re
+	}
+	return r.regex.MatchString(path)
+}
+
+// ignorePatternToRegexp converts an ignore pattern to a regular expression.
+// A "*" matches anything but a separator, "**" matches any number of
+// directories and "?" matches a single character that isn't a separator.
+func ignorePatternToRegexp(pattern string) string {
+	sep := string(os.PathSeparator)
+	escSep := regexp.QuoteMeta(sep)
+
+	var scan scanner.Scanner
+	scan.Init(strings.NewReader(pattern))
+
+	re := "^"
+	for scan.Peek() != scanner.EOF {
+		ch := scan.Next()
+		switch {
+		case ch == '*' && scan.Peek() == '*':
+			scan.Next()
+			// Treat "**/" as "**".
+			if string(scan.Peek()) == sep {
+				scan.Next()
+			}
+			if scan.Peek() == scanner.EOF {
+				re += ".*"
+			} else {
+				re += "(.*" + escSep + ")?"
+			}
+		case ch == '*':
+			re += "[^" + escSep + "]*"
+		case ch == '?':
+			re += "[^" + escSep + "]"
+		case ch == '.' || ch == '$':
+			re += `\` + string(ch)
+		case ch == '\\':
+			// Escape the next character, except on Windows, where the
+			// backslash is the separator.
+			switch {
+			case sep == `\`:
+				re += escSep
+			case scan.Peek() != scanner.EOF:
+				re += `\` + string(scan.Next())
+			default:
+				re += `\`
+			}
+		default:
+			re += string(ch)
+		}
+	}
+
+	return re + "$"
+}
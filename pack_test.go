@@ -0,0 +1,171 @@
+package tfe
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackContents(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tfe-pack")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFile := func(name, content string, mode os.FileMode) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), mode))
+		require.NoError(t, os.Chmod(path, mode))
+	}
+
+	writeFile("main.tf", `module "app" { source = "./modules/app" }`, 0644)
+	writeFile("modules/app/main.tf", `resource "null_resource" "app" {}`, 0644)
+	writeFile("scripts/run.sh", "#!/bin/sh\n", 0755)
+	writeFile("secrets/prod.tfvars", "token = \"secret\"\n", 0600)
+	writeFile("debug.log", "debug\n", 0644)
+	writeFile(".git/HEAD", "ref: refs/heads/main\n", 0644)
+	writeFile(".terraform/terraform.tfstate", "{}", 0644)
+	writeFile(".terraformignore", "# Local files\n*.log\nsecrets/\n", 0644)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "empty"), 0755))
+	require.NoError(t, os.Symlink("modules/app/main.tf", filepath.Join(dir, "app.tf")))
+
+	t.Run("with a valid directory", func(t *testing.T) {
+		r, err := PackContents(dir)
+		require.NoError(t, err)
+		defer r.Close()
+
+		entries := readArchive(t, r)
+
+		assert.Contains(t, entries, "main.tf")
+		assert.Contains(t, entries, "modules/app/main.tf")
+		assert.Contains(t, entries, "empty/")
+		assert.Equal(t, int64(0755), entries["scripts/run.sh"].Mode)
+		assert.Equal(t, int64(0644), entries["main.tf"].Mode)
+
+		link := entries["app.tf"]
+		require.NotNil(t, link)
+		assert.Equal(t, byte(tar.TypeSymlink), link.Typeflag)
+		assert.Equal(t, "modules/app/main.tf", link.Linkname)
+
+		for _, name := range []string{"debug.log", "secrets/prod.tfvars", ".git/HEAD", ".terraform/terraform.tfstate"} {
+			assert.NotContains(t, entries, name)
+		}
+	})
+
+	t.Run("with a symlink escaping the directory", func(t *testing.T) {
+		outside, err := ioutil.TempDir("", "tfe-pack-outside")
+		require.NoError(t, err)
+		defer os.RemoveAll(outside)
+
+		link := filepath.Join(dir, "modules", "shared")
+		require.NoError(t, os.Symlink(outside, link))
+		defer os.Remove(link)
+
+		r, err := PackContents(dir)
+		assert.Nil(t, r)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has a target outside of")
+	})
+
+	t.Run("with a symlink escaping the directory from the modules", func(t *testing.T) {
+		outside, err := ioutil.TempDir("", "tfe-pack-outside")
+		require.NoError(t, err)
+		defer os.RemoveAll(outside)
+
+		link := filepath.Join(dir, ".terraform", "modules", "shared")
+		require.NoError(t, os.MkdirAll(filepath.Dir(link), 0755))
+		require.NoError(t, os.Symlink(outside, link))
+		defer os.Remove(link)
+
+		r, err := PackContents(dir)
+		assert.Nil(t, r)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has a target outside of")
+	})
+
+	t.Run("with a symlink escaping the directory from an ignored path", func(t *testing.T) {
+		outside, err := ioutil.TempDir("", "tfe-pack-outside")
+		require.NoError(t, err)
+		defer os.RemoveAll(outside)
+
+		for _, name := range []string{"secrets/shared", ".terraform/providers/shared", "shared.log"} {
+			link := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(link), 0755))
+			require.NoError(t, os.Symlink(outside, link))
+			defer os.Remove(link)
+		}
+
+		r, err := PackContents(dir)
+		require.NoError(t, err)
+		defer r.Close()
+
+		entries := readArchive(t, r)
+		assert.Contains(t, entries, "main.tf")
+		assert.NotContains(t, entries, "secrets/shared")
+	})
+
+	t.Run("with a blank line of whitespace in the ignore file", func(t *testing.T) {
+		ignore := filepath.Join(dir, ".terraformignore")
+		require.NoError(t, ioutil.WriteFile(ignore, []byte("*.log\n  \t\nsecrets/\n"), 0644))
+		defer ioutil.WriteFile(ignore, []byte("# Local files\n*.log\nsecrets/\n"), 0644)
+
+		rules := parseIgnoreRules(dir)
+		assert.Len(t, rules, len(defaultIgnoreRules())+2)
+
+		r, err := PackContents(dir)
+		require.NoError(t, err)
+		defer r.Close()
+
+		_, err = ioutil.ReadAll(r)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to pack")
+	})
+
+	t.Run("when the reader is closed early", func(t *testing.T) {
+		r, err := PackContents(dir)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+
+		_, err = r.Read(make([]byte, 1))
+		assert.Equal(t, io.ErrClosedPipe, err)
+	})
+
+	t.Run("with a file instead of a directory", func(t *testing.T) {
+		r, err := PackContents(filepath.Join(dir, "main.tf"))
+		assert.Nil(t, r)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not a directory")
+	})
+
+	t.Run("with a nonexisting directory", func(t *testing.T) {
+		r, err := PackContents(filepath.Join(dir, "nonexisting"))
+		assert.Nil(t, r)
+		assert.Error(t, err)
+	})
+}
+
+// readArchive reads all entries of a gzipped tarball, keyed by name.
+func readArchive(t *testing.T, r io.Reader) map[string]*tar.Header {
+	gzipR, err := gzip.NewReader(r)
+	require.NoError(t, err)
+
+	entries := make(map[string]*tar.Header)
+	tarR := tar.NewReader(gzipR)
+	for {
+		header, err := tarR.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		entries[header.Name] = header
+	}
+
+	return entries
+}
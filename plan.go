@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -20,8 +21,13 @@ type Plans interface {
 	// Read a plan by its ID.
 	Read(ctx context.Context, planID string) (*Plan, error)
 
-	// Logs retrieves the logs of a plan.
+	// Logs retrieves the logs of a plan. The returned reader keeps
+	// streaming new output until the plan reaches a final state.
 	Logs(ctx context.Context, planID string) (io.Reader, error)
+
+	// ReadGeneratedConfiguration downloads the configuration generated by
+	// the plan for the resources it imported.
+	ReadGeneratedConfiguration(ctx context.Context, planID string) ([]byte, error)
 }
 
 // plans implements Plans.
@@ -127,10 +133,44 @@ func (s *plans) Logs(ctx context.Context, planID string) (io.Reader, error) {
 		}
 	}
 
+	refresh := func() (*url.URL, error) {
+		p, err := s.Read(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		return url.Parse(p.LogReadURL)
+	}
+
 	return &LogReader{
-		client: s.client,
-		ctx:    ctx,
-		done:   done,
-		logURL: u,
+		client:  s.client,
+		ctx:     ctx,
+		done:    done,
+		refresh: refresh,
+		logURL:  u,
 	}, nil
 }
+
+// ReadGeneratedConfiguration downloads the configuration generated by the plan
+// for the resources it imported, when the plan was created with configuration
+// generation enabled. It returns ErrResourceNotFound when the plan did not
+// generate any configuration.
+func (s *plans) ReadGeneratedConfiguration(ctx context.Context, planID string) ([]byte, error) {
+	if !validStringID(&planID) {
+		return nil, errors.New("invalid value for plan ID")
+	}
+
+	u := fmt.Sprintf("plans/%s/generated-configuration", url.QueryEscape(planID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "*/*")
+
+	var buf bytes.Buffer
+	err = s.client.do(ctx, req, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
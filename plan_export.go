@@ -85,18 +85,20 @@ type PlanExportCreateOptions struct {
 	DataType *PlanExportDataType `jsonapi:"attr,data-type"`
 }
 
-func (o PlanExportCreateOptions) valid() error {
+// Validate checks the plan export create options for errors, without making an
+// API request.
+func (o PlanExportCreateOptions) Validate() error {
 	if o.Plan == nil {
-		return errors.New("plan is required")
+		return validationError("plan is required")
 	}
 	if o.DataType == nil {
-		return errors.New("data type is required")
+		return validationError("data type is required")
 	}
 	return nil
 }
 
 func (s *planExports) Create(ctx context.Context, options PlanExportCreateOptions) (*PlanExport, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -3,6 +3,9 @@ package tfe
 import (
 	"context"
 	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -64,3 +67,97 @@ func TestPlansLogs(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestPlansLogsStreaming(t *testing.T) {
+	// The log grows with every request, like the log of a running plan.
+	chunks := []string{"\x02Refreshing state...\n", "Plan: 1 to add, ", "0 to change, 0 to destroy.\n\x03"}
+
+	var mu sync.Mutex
+	var log string
+	var offsets []int
+	var logRequests int
+
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/api/tfe/v2/plans/plan-123":
+			status := PlanRunning
+			if logRequests >= len(chunks) {
+				status = PlanFinished
+			}
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			w.Write([]byte(`{"data": {"id": "plan-123", "type": "plans", "attributes": {
+				"status": "` + string(status) + `", "resource-additions": 1,
+				"log-read-url": "http://` + r.Host + `/logs/plan-123"}}}`))
+		case "/logs/plan-123":
+			if logRequests < len(chunks) {
+				log += chunks[logRequests]
+			}
+			logRequests++
+
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			offsets = append(offsets, offset)
+
+			end := offset + limit
+			if end > len(log) {
+				end = len(log)
+			}
+			w.Write([]byte(log[offset:end]))
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+	})
+
+	logReader, err := client.Plans.Logs(context.Background(), "plan-123")
+	require.NoError(t, err)
+
+	logs, err := ioutil.ReadAll(logReader)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Refreshing state...\nPlan: 1 to add, 0 to change, 0 to destroy.\n", string(logs))
+
+	// Every chunk must be requested from the end of the previous one.
+	assert.Equal(t, 0, offsets[0])
+	for i := 1; i < len(offsets); i++ {
+		assert.True(t, offsets[i] >= offsets[i-1], "offsets must never go back: %v", offsets)
+	}
+}
+
+func TestPlansReadGeneratedConfiguration(t *testing.T) {
+	generated := `resource "aws_s3_bucket" "logs" {
+  bucket = "acme-logs"
+}
+`
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/plans/plan-123/generated-configuration":
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte(generated))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the plan generated configuration", func(t *testing.T) {
+		cfg, err := client.Plans.ReadGeneratedConfiguration(ctx, "plan-123")
+		require.NoError(t, err)
+		assert.Equal(t, generated, string(cfg))
+	})
+
+	t.Run("when the plan did not generate configuration", func(t *testing.T) {
+		cfg, err := client.Plans.ReadGeneratedConfiguration(ctx, "plan-456")
+		assert.Nil(t, cfg)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with an invalid plan ID", func(t *testing.T) {
+		cfg, err := client.Plans.ReadGeneratedConfiguration(ctx, badIdentifier)
+		assert.Nil(t, cfg)
+		assert.EqualError(t, err, "invalid value for plan ID")
+	})
+}
@@ -66,6 +66,7 @@ type Policy struct {
 	Name           string         `jsonapi:"attr,name"`
 	Description    string         `jsonapi:"attr,description"`
 	Enforce        []*Enforcement `jsonapi:"attr,enforce"`
+	Kind           PolicyKind     `jsonapi:"attr,kind"`
 	PolicySetCount int            `jsonapi:"attr,policy-set-count"`
 	UpdatedAt      time.Time      `jsonapi:"attr,updated-at,iso8601"`
 
@@ -89,8 +90,9 @@ type PolicyListOptions struct {
 
 // List all the policies for a given organization
 func (s *policies) List(ctx context.Context, organization string, options PolicyListOptions) (*PolicyList, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/policies", url.QueryEscape(organization))
@@ -119,6 +121,9 @@ type PolicyCreateOptions struct {
 	// A description of the policy's purpose.
 	Description *string `jsonapi:"attr,description,omitempty"`
 
+	// The kind of the policy, defaults to sentinel.
+	Kind *PolicyKind `jsonapi:"attr,kind,omitempty"`
+
 	// The enforcements of the policy.
 	Enforce []*EnforcementOptions `jsonapi:"attr,enforce"`
 }
@@ -129,22 +134,30 @@ type EnforcementOptions struct {
 	Mode *EnforcementLevel `json:"mode"`
 }
 
-func (o PolicyCreateOptions) valid() error {
+// Validate checks the policy create options for errors, without making an API
+// request.
+func (o PolicyCreateOptions) Validate() error {
 	if !validString(o.Name) {
-		return errors.New("name is required")
+		return validationError("name is required")
 	}
 	if !validStringID(o.Name) {
-		return errors.New("invalid value for name")
+		return validationError("invalid value for name")
+	}
+	if o.Kind != nil && !validPolicyKind(*o.Kind) {
+		return validationError("invalid value for kind")
 	}
 	if o.Enforce == nil {
-		return errors.New("enforce is required")
+		return validationError("enforce is required")
 	}
 	for _, e := range o.Enforce {
 		if !validString(e.Path) {
-			return errors.New("enforcement path is required")
+			return validationError("enforcement path is required")
 		}
 		if e.Mode == nil {
-			return errors.New("enforcement mode is required")
+			return validationError("enforcement mode is required")
+		}
+		if !validEnforcementLevel(*e.Mode) {
+			return validationError("invalid value for enforcement mode")
 		}
 	}
 	return nil
@@ -152,10 +165,11 @@ func (o PolicyCreateOptions) valid() error {
 
 // Create a policy and associate it with an organization.
 func (s *policies) Create(ctx context.Context, organization string, options PolicyCreateOptions) (*Policy, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -210,11 +224,25 @@ type PolicyUpdateOptions struct {
 	Enforce []*EnforcementOptions `jsonapi:"attr,enforce,omitempty"`
 }
 
+// Validate checks the policy update options for errors, without making an API
+// request.
+func (o PolicyUpdateOptions) Validate() error {
+	for _, e := range o.Enforce {
+		if e.Mode != nil && !validEnforcementLevel(*e.Mode) {
+			return validationError("invalid value for enforcement mode")
+		}
+	}
+	return nil
+}
+
 // Update an existing policy.
 func (s *policies) Update(ctx context.Context, policyID string, options PolicyUpdateOptions) (*Policy, error) {
 	if !validStringID(&policyID) {
 		return nil, errors.New("invalid value for policy ID")
 	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
@@ -284,3 +312,19 @@ func (s *policies) Download(ctx context.Context, policyID string) ([]byte, error
 
 	return buf.Bytes(), nil
 }
+
+func validEnforcementLevel(v EnforcementLevel) bool {
+	switch v {
+	case EnforcementAdvisory, EnforcementHard, EnforcementSoft:
+		return true
+	}
+	return false
+}
+
+func validPolicyKind(v PolicyKind) bool {
+	switch v {
+	case PolicyKindOPA, PolicyKindSentinel:
+		return true
+	}
+	return false
+}
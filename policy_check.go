@@ -25,7 +25,9 @@ type PolicyChecks interface {
 	// Read a policy check by its ID.
 	Read(ctx context.Context, policyCheckID string) (*PolicyCheck, error)
 
-	// Override a soft-mandatory or warning policy.
+	// Override a soft-mandatory or warning policy. Returns
+	// ErrPolicyCheckNotOverridable when the policy check can't be
+	// overridden.
 	Override(ctx context.Context, policyCheckID string) (*PolicyCheck, error)
 
 	// Logs retrieves the logs of a policy check.
@@ -2,7 +2,9 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -168,6 +170,37 @@ func TestPolicyChecksOverride(t *testing.T) {
 	})
 }
 
+func TestPolicyChecksOverrideConflict(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		switch r.URL.Path {
+		case "/api/tfe/v2/policy-checks/polchk-soft/actions/override":
+			w.Write([]byte(`{"data": {"id": "polchk-soft", "type": "policy-checks", "attributes": {
+				"status": "overridden", "result": {"soft-failed": 1, "passed": 2}}}}`))
+		case "/api/tfe/v2/policy-checks/polchk-hard/actions/override":
+			w.WriteHeader(409)
+			w.Write([]byte(`{"errors": [{"status": "409", "title": "conflict", "detail": "policy check is not overridable"}]}`))
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the policy check is overridable", func(t *testing.T) {
+		pc, err := client.PolicyChecks.Override(ctx, "polchk-soft")
+		require.NoError(t, err)
+		assert.Equal(t, PolicyOverridden, pc.Status)
+		require.NotNil(t, pc.Result)
+		assert.Equal(t, 1, pc.Result.SoftFailed)
+	})
+
+	t.Run("when the policy check is not overridable", func(t *testing.T) {
+		pc, err := client.PolicyChecks.Override(ctx, "polchk-hard")
+		assert.Nil(t, pc)
+		assert.Equal(t, ErrPolicyCheckNotOverridable, err)
+		assert.True(t, errors.Is(err, ErrConflict))
+	})
+}
+
 func TestPolicyChecksLogs(t *testing.T) {
 	t.Skip("Unsupported resource - policies")
 	client := testClient(t)
@@ -0,0 +1,218 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ PolicyEvaluations = (*policyEvaluations)(nil)
+
+// PolicyEvaluations describes all the policy evaluation related methods that
+// the Terraform Enterprise API supports. Policy evaluations are the results
+// of evaluating the Sentinel and OPA policy sets during a task stage of a
+// run.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/policy-evaluations.html
+type PolicyEvaluations interface {
+	// List all the policy evaluations of the given task stage.
+	List(ctx context.Context, taskStageID string, options PolicyEvaluationListOptions) (*PolicyEvaluationList, error)
+
+	// ListPolicySetOutcomes lists the outcomes of the individual policy sets
+	// of the given policy evaluation.
+	ListPolicySetOutcomes(ctx context.Context, policyEvaluationID string, options PolicySetOutcomeListOptions) (*PolicySetOutcomeList, error)
+
+	// ReadPolicySetOutcome reads a policy set outcome by its ID.
+	ReadPolicySetOutcome(ctx context.Context, policySetOutcomeID string) (*PolicySetOutcome, error)
+}
+
+// policyEvaluations implements PolicyEvaluations.
+type policyEvaluations struct {
+	client *Client
+}
+
+// PolicyKind represents the kind of a policy.
+type PolicyKind string
+
+// List all available policy kinds.
+const (
+	PolicyKindOPA      PolicyKind = "opa"
+	PolicyKindSentinel PolicyKind = "sentinel"
+)
+
+// PolicyEvaluationStatus represents the status of a policy evaluation.
+type PolicyEvaluationStatus string
+
+// List all available policy evaluation statuses.
+const (
+	PolicyEvaluationCanceled    PolicyEvaluationStatus = "canceled"
+	PolicyEvaluationErrored     PolicyEvaluationStatus = "errored"
+	PolicyEvaluationFailed      PolicyEvaluationStatus = "failed"
+	PolicyEvaluationOverridden  PolicyEvaluationStatus = "overridden"
+	PolicyEvaluationPassed      PolicyEvaluationStatus = "passed"
+	PolicyEvaluationPending     PolicyEvaluationStatus = "pending"
+	PolicyEvaluationQueued      PolicyEvaluationStatus = "queued"
+	PolicyEvaluationRunning     PolicyEvaluationStatus = "running"
+	PolicyEvaluationUnreachable PolicyEvaluationStatus = "unreachable"
+)
+
+// PolicyEvaluationList represents a list of policy evaluations.
+type PolicyEvaluationList struct {
+	*Pagination
+	Items []*PolicyEvaluation
+}
+
+// PolicyEvaluation represents the evaluation of all policy sets of one kind
+// during a task stage.
+type PolicyEvaluation struct {
+	ID               string                            `jsonapi:"primary,policy-evaluations"`
+	CreatedAt        time.Time                         `jsonapi:"attr,created-at,iso8601"`
+	PolicyKind       PolicyKind                        `jsonapi:"attr,policy-kind"`
+	ResultCount      *PolicyResultCount                `jsonapi:"attr,result-count"`
+	Status           PolicyEvaluationStatus            `jsonapi:"attr,status"`
+	StatusTimestamps *PolicyEvaluationStatusTimestamps `jsonapi:"attr,status-timestamps"`
+	UpdatedAt        time.Time                         `jsonapi:"attr,updated-at,iso8601"`
+}
+
+// PolicyResultCount represents the number of policies per result.
+type PolicyResultCount struct {
+	AdvisoryFailed  int `json:"advisory-failed"`
+	MandatoryFailed int `json:"mandatory-failed"`
+	Passed          int `json:"passed"`
+	Errored         int `json:"errored"`
+}
+
+// PolicyEvaluationStatusTimestamps holds the timestamps for individual policy
+// evaluation statuses.
+type PolicyEvaluationStatusTimestamps struct {
+	CanceledAt time.Time `json:"canceled-at"`
+	ErroredAt  time.Time `json:"errored-at"`
+	FailedAt   time.Time `json:"failed-at"`
+	PassedAt   time.Time `json:"passed-at"`
+	QueuedAt   time.Time `json:"queued-at"`
+	RunningAt  time.Time `json:"running-at"`
+}
+
+// PolicyEvaluationListOptions represents the options for listing policy
+// evaluations.
+type PolicyEvaluationListOptions struct {
+	ListOptions
+}
+
+// List all the policy evaluations of the given task stage.
+func (s *policyEvaluations) List(ctx context.Context, taskStageID string, options PolicyEvaluationListOptions) (*PolicyEvaluationList, error) {
+	if !validStringID(&taskStageID) {
+		return nil, errors.New("invalid value for task stage ID")
+	}
+
+	u := fmt.Sprintf("task-stages/%s/policy-evaluations", url.QueryEscape(taskStageID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pel := &PolicyEvaluationList{}
+	err = s.client.do(ctx, req, pel)
+	if err != nil {
+		return nil, err
+	}
+
+	return pel, nil
+}
+
+// PolicySetOutcomeList represents a list of policy set outcomes.
+type PolicySetOutcomeList struct {
+	*Pagination
+	Items []*PolicySetOutcome
+}
+
+// PolicySetOutcome represents the outcome of evaluating a single policy set,
+// including the result of every policy in the set.
+type PolicySetOutcome struct {
+	ID                   string             `jsonapi:"primary,policy-set-outcomes"`
+	Error                string             `jsonapi:"attr,error"`
+	Outcomes             []Outcome          `jsonapi:"attr,outcomes"`
+	Overridable          *bool              `jsonapi:"attr,overridable"`
+	PolicySetName        string             `jsonapi:"attr,policy-set-name"`
+	PolicySetDescription string             `jsonapi:"attr,policy-set-description"`
+	ResultCount          *PolicyResultCount `jsonapi:"attr,result-count"`
+
+	// Relations
+	PolicyEvaluation *PolicyEvaluation `jsonapi:"relation,policy-evaluation"`
+}
+
+// Passed reports whether none of the policies of the set failed or errored.
+// Failed advisory policies are counted as failures as well.
+func (o *PolicySetOutcome) Passed() bool {
+	if o.Error != "" {
+		return false
+	}
+	if o.ResultCount == nil {
+		return true
+	}
+	return o.ResultCount.AdvisoryFailed == 0 &&
+		o.ResultCount.MandatoryFailed == 0 &&
+		o.ResultCount.Errored == 0
+}
+
+// Outcome represents the result of evaluating a single policy.
+type Outcome struct {
+	EnforcementLevel EnforcementLevel `json:"enforcement_level"`
+	Query            string           `json:"query"`
+	Status           string           `json:"status"`
+	PolicyName       string           `json:"policy_name"`
+	Description      string           `json:"description"`
+}
+
+// PolicySetOutcomeListOptions represents the options for listing policy set
+// outcomes.
+type PolicySetOutcomeListOptions struct {
+	ListOptions
+}
+
+// ListPolicySetOutcomes lists the outcomes of the individual policy sets of
+// the given policy evaluation.
+func (s *policyEvaluations) ListPolicySetOutcomes(ctx context.Context, policyEvaluationID string, options PolicySetOutcomeListOptions) (*PolicySetOutcomeList, error) {
+	if !validStringID(&policyEvaluationID) {
+		return nil, errors.New("invalid value for policy evaluation ID")
+	}
+
+	u := fmt.Sprintf("policy-evaluations/%s/policy-set-outcomes", url.QueryEscape(policyEvaluationID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	psol := &PolicySetOutcomeList{}
+	err = s.client.do(ctx, req, psol)
+	if err != nil {
+		return nil, err
+	}
+
+	return psol, nil
+}
+
+// ReadPolicySetOutcome reads a policy set outcome by its ID.
+func (s *policyEvaluations) ReadPolicySetOutcome(ctx context.Context, policySetOutcomeID string) (*PolicySetOutcome, error) {
+	if !validStringID(&policySetOutcomeID) {
+		return nil, errors.New("invalid value for policy set outcome ID")
+	}
+
+	u := fmt.Sprintf("policy-set-outcomes/%s", url.QueryEscape(policySetOutcomeID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pso := &PolicySetOutcome{}
+	err = s.client.do(ctx, req, pso)
+	if err != nil {
+		return nil, err
+	}
+
+	return pso, nil
+}
@@ -0,0 +1,97 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyEvaluationsList(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/task-stages/ts-123/policy-evaluations", r.URL.Path)
+		w.Write([]byte(`{"data": [{"id": "poleval-123", "type": "policy-evaluations", "attributes": {
+			"status": "failed", "policy-kind": "opa",
+			"result-count": {"advisory-failed": 0, "mandatory-failed": 1, "passed": 3, "errored": 0}}}],
+			"meta": {"pagination": {"current-page": 1, "total-count": 1}}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with a valid task stage ID", func(t *testing.T) {
+		pel, err := client.PolicyEvaluations.List(ctx, "ts-123", PolicyEvaluationListOptions{})
+		require.NoError(t, err)
+		require.Len(t, pel.Items, 1)
+
+		pe := pel.Items[0]
+		assert.Equal(t, PolicyEvaluationFailed, pe.Status)
+		assert.Equal(t, PolicyKindOPA, pe.PolicyKind)
+		require.NotNil(t, pe.ResultCount)
+		assert.Equal(t, 1, pe.ResultCount.MandatoryFailed)
+		assert.Equal(t, 3, pe.ResultCount.Passed)
+	})
+
+	t.Run("without a valid task stage ID", func(t *testing.T) {
+		pel, err := client.PolicyEvaluations.List(ctx, badIdentifier, PolicyEvaluationListOptions{})
+		assert.Nil(t, pel)
+		assert.EqualError(t, err, "invalid value for task stage ID")
+	})
+}
+
+func TestPolicyEvaluationsListPolicySetOutcomes(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/policy-evaluations/poleval-123/policy-set-outcomes", r.URL.Path)
+		w.Write([]byte(`{"data": [
+			{"id": "psout-1", "type": "policy-set-outcomes", "attributes": {
+				"policy-set-name": "network", "overridable": true,
+				"outcomes": [
+					{"enforcement_level": "mandatory", "query": "data.terraform.deny", "status": "failed", "policy_name": "no-public-ips"},
+					{"enforcement_level": "advisory", "query": "data.terraform.warn", "status": "passed", "policy_name": "tags"}
+				],
+				"result-count": {"advisory-failed": 0, "mandatory-failed": 1, "passed": 1, "errored": 0}},
+				"relationships": {"policy-evaluation": {"data": {"id": "poleval-123", "type": "policy-evaluations"}}}},
+			{"id": "psout-2", "type": "policy-set-outcomes", "attributes": {
+				"policy-set-name": "cost", "outcomes": [],
+				"result-count": {"advisory-failed": 0, "mandatory-failed": 0, "passed": 2, "errored": 0}}}],
+			"meta": {"pagination": {"current-page": 1, "total-count": 2}}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with a valid policy evaluation ID", func(t *testing.T) {
+		psol, err := client.PolicyEvaluations.ListPolicySetOutcomes(ctx, "poleval-123", PolicySetOutcomeListOptions{})
+		require.NoError(t, err)
+		require.Len(t, psol.Items, 2)
+
+		pso := psol.Items[0]
+		assert.Equal(t, "network", pso.PolicySetName)
+		assert.Equal(t, Bool(true), pso.Overridable)
+		assert.False(t, pso.Passed())
+		require.Len(t, pso.Outcomes, 2)
+		assert.Equal(t, "no-public-ips", pso.Outcomes[0].PolicyName)
+		assert.Equal(t, "failed", pso.Outcomes[0].Status)
+		require.NotNil(t, pso.PolicyEvaluation)
+		assert.Equal(t, "poleval-123", pso.PolicyEvaluation.ID)
+
+		assert.True(t, psol.Items[1].Passed())
+	})
+
+	t.Run("without a valid policy evaluation ID", func(t *testing.T) {
+		psol, err := client.PolicyEvaluations.ListPolicySetOutcomes(ctx, badIdentifier, PolicySetOutcomeListOptions{})
+		assert.Nil(t, psol)
+		assert.EqualError(t, err, "invalid value for policy evaluation ID")
+	})
+}
+
+func TestPolicyEvaluationsReadPolicySetOutcome(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("with invalid policy set outcome ID", func(t *testing.T) {
+		pso, err := client.PolicyEvaluations.ReadPolicySetOutcome(ctx, badIdentifier)
+		assert.Nil(t, pso)
+		assert.EqualError(t, err, "invalid value for policy set outcome ID")
+	})
+}
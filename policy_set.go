@@ -86,8 +86,9 @@ type PolicySetListOptions struct {
 
 // List all the policies for a given organization.
 func (s *policySets) List(ctx context.Context, organization string, options PolicySetListOptions) (*PolicySetList, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/policy-sets", url.QueryEscape(organization))
@@ -116,7 +117,8 @@ type PolicySetCreateOptions struct {
 	// The description of the policy set.
 	Description *string `jsonapi:"attr,description,omitempty"`
 
-	// Whether or not the policy set is global.
+	// Whether or not the policy set is global. A global policy set is
+	// enforced on all workspaces of the organization.
 	Global *bool `jsonapi:"attr,global,omitempty"`
 
 	// The sub-path within the attached VCS repository to ingress. All
@@ -138,22 +140,31 @@ type PolicySetCreateOptions struct {
 	Workspaces []*Workspace `jsonapi:"relation,workspaces,omitempty"`
 }
 
-func (o PolicySetCreateOptions) valid() error {
+// Validate checks the policy set create options for errors, without making an
+// API request.
+func (o PolicySetCreateOptions) Validate() error {
 	if !validString(o.Name) {
-		return errors.New("name is required")
+		return validationError("name is required")
 	}
 	if !validStringID(o.Name) {
-		return errors.New("invalid value for name")
+		return validationError("invalid value for name")
+	}
+	if o.Global != nil && *o.Global && len(o.Workspaces) > 0 {
+		return validationError("workspaces can only be set when the policy set is not global")
+	}
+	if o.VCSRepo != nil && len(o.Policies) > 0 {
+		return validationError("policies can only be set when no VCS repo is present")
 	}
 	return nil
 }
 
 // Create a policy set and associate it with an organization.
 func (s *policySets) Create(ctx context.Context, organization string, options PolicySetCreateOptions) (*PolicySet, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -211,9 +222,11 @@ type PolicySetUpdateOptions struct {
 	Global *bool `jsonapi:"attr,global,omitempty"`
 }
 
-func (o PolicySetUpdateOptions) valid() error {
+// Validate checks the policy set update options for errors, without making an
+// API request.
+func (o PolicySetUpdateOptions) Validate() error {
 	if o.Name != nil && !validStringID(o.Name) {
-		return errors.New("invalid value for name")
+		return validationError("invalid value for name")
 	}
 	return nil
 }
@@ -223,7 +236,7 @@ func (s *policySets) Update(ctx context.Context, policySetID string, options Pol
 	if !validStringID(&policySetID) {
 		return nil, errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -252,12 +265,14 @@ type PolicySetAddPoliciesOptions struct {
 	Policies []*Policy
 }
 
-func (o PolicySetAddPoliciesOptions) valid() error {
+// Validate checks the policy set add policies options for errors, without
+// making an API request.
+func (o PolicySetAddPoliciesOptions) Validate() error {
 	if o.Policies == nil {
-		return errors.New("policies is required")
+		return validationError("policies is required")
 	}
 	if len(o.Policies) == 0 {
-		return errors.New("must provide at least one policy")
+		return validationError("must provide at least one policy")
 	}
 	return nil
 }
@@ -267,7 +282,7 @@ func (s *policySets) AddPolicies(ctx context.Context, policySetID string, option
 	if !validStringID(&policySetID) {
 		return errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
@@ -287,12 +302,14 @@ type PolicySetRemovePoliciesOptions struct {
 	Policies []*Policy
 }
 
-func (o PolicySetRemovePoliciesOptions) valid() error {
+// Validate checks the policy set remove policies options for errors, without
+// making an API request.
+func (o PolicySetRemovePoliciesOptions) Validate() error {
 	if o.Policies == nil {
-		return errors.New("policies is required")
+		return validationError("policies is required")
 	}
 	if len(o.Policies) == 0 {
-		return errors.New("must provide at least one policy")
+		return validationError("must provide at least one policy")
 	}
 	return nil
 }
@@ -302,7 +319,7 @@ func (s *policySets) RemovePolicies(ctx context.Context, policySetID string, opt
 	if !validStringID(&policySetID) {
 		return errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
@@ -322,12 +339,14 @@ type PolicySetAddWorkspacesOptions struct {
 	Workspaces []*Workspace
 }
 
-func (o PolicySetAddWorkspacesOptions) valid() error {
+// Validate checks the policy set add workspaces options for errors, without
+// making an API request.
+func (o PolicySetAddWorkspacesOptions) Validate() error {
 	if o.Workspaces == nil {
-		return errors.New("workspaces is required")
+		return validationError("workspaces is required")
 	}
 	if len(o.Workspaces) == 0 {
-		return errors.New("must provide at least one workspace")
+		return validationError("must provide at least one workspace")
 	}
 	return nil
 }
@@ -337,7 +356,7 @@ func (s *policySets) AddWorkspaces(ctx context.Context, policySetID string, opti
 	if !validStringID(&policySetID) {
 		return errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
@@ -357,12 +376,14 @@ type PolicySetRemoveWorkspacesOptions struct {
 	Workspaces []*Workspace
 }
 
-func (o PolicySetRemoveWorkspacesOptions) valid() error {
+// Validate checks the policy set remove workspaces options for errors, without
+// making an API request.
+func (o PolicySetRemoveWorkspacesOptions) Validate() error {
 	if o.Workspaces == nil {
-		return errors.New("workspaces is required")
+		return validationError("workspaces is required")
 	}
 	if len(o.Workspaces) == 0 {
-		return errors.New("must provide at least one workspace")
+		return validationError("must provide at least one workspace")
 	}
 	return nil
 }
@@ -372,7 +393,7 @@ func (s *policySets) RemoveWorkspaces(ctx context.Context, policySetID string, o
 	if !validStringID(&policySetID) {
 		return errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
@@ -59,7 +59,9 @@ type PolicySetParameterListOptions struct {
 	ListOptions
 }
 
-func (o PolicySetParameterListOptions) valid() error {
+// Validate checks the policy set parameter list options for errors, without
+// making an API request.
+func (o PolicySetParameterListOptions) Validate() error {
 	return nil
 }
 
@@ -68,7 +70,7 @@ func (s *policySetParameters) List(ctx context.Context, policySetID string, opti
 	if !validStringID(&policySetID) {
 		return nil, errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -105,15 +107,17 @@ type PolicySetParameterCreateOptions struct {
 	Sensitive *bool `jsonapi:"attr,sensitive,omitempty"`
 }
 
-func (o PolicySetParameterCreateOptions) valid() error {
+// Validate checks the policy set parameter create options for errors, without
+// making an API request.
+func (o PolicySetParameterCreateOptions) Validate() error {
 	if !validString(o.Key) {
-		return errors.New("key is required")
+		return validationError("key is required")
 	}
 	if o.Category == nil {
-		return errors.New("category is required")
+		return validationError("category is required")
 	}
 	if *o.Category != CategoryPolicySet {
-		return errors.New("category must be policy-set")
+		return validationError("category must be policy-set")
 	}
 	return nil
 }
@@ -123,7 +127,7 @@ func (s *policySetParameters) Create(ctx context.Context, policySetID string, op
 	if !validStringID(&policySetID) {
 		return nil, errors.New("invalid value for policy set ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -181,6 +181,44 @@ func TestPolicySetsCreate(t *testing.T) {
 	})
 }
 
+func TestPolicySetCreateOptionsValidate(t *testing.T) {
+	t.Run("with a global policy set and workspaces", func(t *testing.T) {
+		options := PolicySetCreateOptions{
+			Name:       String("baseline"),
+			Global:     Bool(true),
+			Workspaces: []*Workspace{{ID: "ws-123"}},
+		}
+
+		err := options.Validate()
+		assert.EqualError(t, err, "workspaces can only be set when the policy set is not global")
+	})
+
+	t.Run("with a VCS repo and policies", func(t *testing.T) {
+		options := PolicySetCreateOptions{
+			Name:     String("baseline"),
+			Policies: []*Policy{{ID: "pol-123"}},
+			VCSRepo: &VCSRepoOptions{
+				Identifier:   String("acme/policies"),
+				OAuthTokenID: String("ot-123"),
+			},
+		}
+
+		err := options.Validate()
+		assert.EqualError(t, err, "policies can only be set when no VCS repo is present")
+	})
+
+	t.Run("with a policy set that is not global and workspaces", func(t *testing.T) {
+		options := PolicySetCreateOptions{
+			Name:       String("baseline"),
+			Global:     Bool(false),
+			Policies:   []*Policy{{ID: "pol-123"}},
+			Workspaces: []*Workspace{{ID: "ws-123"}},
+		}
+
+		assert.NoError(t, options.Validate())
+	})
+}
+
 func TestPolicySetsRead(t *testing.T) {
 	t.Skip("Unsupported resource")
 	client := testClient(t)
@@ -2,6 +2,8 @@ package tfe
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -182,6 +184,86 @@ func TestPoliciesCreate(t *testing.T) {
 	})
 }
 
+func TestPoliciesCreateAndUpload(t *testing.T) {
+	var body, uploaded string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/tfe/v2/organizations/acme/policies":
+			body = string(b)
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "pol-123", "type": "policies", "attributes": {
+				"name": "restrict-regions", "kind": "sentinel",
+				"enforce": [{"path": "restrict-regions.sentinel", "mode": "soft-mandatory"}]}}}`))
+		case r.Method == "PUT" && r.URL.Path == "/api/tfe/v2/policies/pol-123/upload":
+			uploaded = string(b)
+			w.WriteHeader(200)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("with a kind and a valid enforcement mode", func(t *testing.T) {
+		kind := PolicyKindSentinel
+		p, err := client.Policies.Create(ctx, "acme", PolicyCreateOptions{
+			Name: String("restrict-regions"),
+			Kind: &kind,
+			Enforce: []*EnforcementOptions{{
+				Path: String("restrict-regions.sentinel"),
+				Mode: EnforcementMode(EnforcementSoft),
+			}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, PolicyKindSentinel, p.Kind)
+		require.Len(t, p.Enforce, 1)
+		assert.Equal(t, EnforcementSoft, p.Enforce[0].Mode)
+		assert.Contains(t, body, `"kind":"sentinel"`)
+
+		err = client.Policies.Upload(ctx, p.ID, []byte(`main = rule { true }`))
+		require.NoError(t, err)
+		assert.Equal(t, `main = rule { true }`, uploaded)
+	})
+
+	t.Run("with an invalid enforcement mode", func(t *testing.T) {
+		p, err := client.Policies.Create(ctx, "acme", PolicyCreateOptions{
+			Name: String("restrict-regions"),
+			Enforce: []*EnforcementOptions{{
+				Path: String("restrict-regions.sentinel"),
+				Mode: EnforcementMode("mandatory"),
+			}},
+		})
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid value for enforcement mode")
+	})
+
+	t.Run("with an invalid kind", func(t *testing.T) {
+		kind := PolicyKind("rego")
+		p, err := client.Policies.Create(ctx, "acme", PolicyCreateOptions{
+			Name: String("restrict-regions"),
+			Kind: &kind,
+			Enforce: []*EnforcementOptions{{
+				Path: String("restrict-regions.sentinel"),
+				Mode: EnforcementMode(EnforcementAdvisory),
+			}},
+		})
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid value for kind")
+	})
+
+	t.Run("when updating with an invalid enforcement mode", func(t *testing.T) {
+		p, err := client.Policies.Update(ctx, "pol-123", PolicyUpdateOptions{
+			Enforce: []*EnforcementOptions{{
+				Path: String("restrict-regions.sentinel"),
+				Mode: EnforcementMode("mandatory"),
+			}},
+		})
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid value for enforcement mode")
+	})
+}
+
 func TestPoliciesRead(t *testing.T) {
 	t.Skip("Unsupported resource")
 	client := testClient(t)
@@ -0,0 +1,254 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Compile-time proof of interface implementation.
+var _ Projects = (*projects)(nil)
+
+// Projects describes all the project related methods that the Terraform
+// Enterprise API supports.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/projects.html
+type Projects interface {
+	// List all the projects of the given organization.
+	List(ctx context.Context, organization string, options ProjectListOptions) (*ProjectList, error)
+
+	// Create a new project with the given options.
+	Create(ctx context.Context, organization string, options ProjectCreateOptions) (*Project, error)
+
+	// Read a project by its ID.
+	Read(ctx context.Context, projectID string) (*Project, error)
+
+	// Update a project by its ID.
+	Update(ctx context.Context, projectID string, options ProjectUpdateOptions) (*Project, error)
+
+	// Delete a project by its ID.
+	Delete(ctx context.Context, projectID string) error
+}
+
+// projects implements Projects.
+type projects struct {
+	client *Client
+}
+
+// ProjectList represents a list of projects.
+type ProjectList struct {
+	*Pagination
+	Items []*Project
+}
+
+// Project represents a Terraform Enterprise project. A project groups
+// workspaces and holds the default settings they can inherit.
+type Project struct {
+	ID                          string            `jsonapi:"primary,projects"`
+	AutoDestroyActivityDuration string            `jsonapi:"attr,auto-destroy-activity-duration"`
+	DefaultExecutionMode        ExecutionModeType `jsonapi:"attr,default-execution-mode"`
+	Name                        string            `jsonapi:"attr,name"`
+
+	// Relations
+	DefaultAgentPool *AgentPool    `jsonapi:"relation,default-agent-pool"`
+	Organization     *Organization `jsonapi:"relation,organization"`
+}
+
+// ProjectListOptions represents the options for listing projects.
+type ProjectListOptions struct {
+	ListOptions
+
+	// Only list the projects with the given name. The name has to match
+	// exactly; a comma separated list matches any of the names.
+	Name *string `url:"filter[names],omitempty"`
+}
+
+// List all the projects of the given organization.
+func (s *projects) List(ctx context.Context, organization string, options ProjectListOptions) (*ProjectList, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s/projects", url.QueryEscape(organization))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pl := &ProjectList{}
+	err = s.client.do(ctx, req, pl)
+	if err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+// ProjectCreateOptions represents the options for creating a project.
+type ProjectCreateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,projects"`
+
+	// The name of the project.
+	Name *string `jsonapi:"attr,name"`
+
+	// How long a workspace may be inactive before it is automatically
+	// destroyed, in days or hours (e.g. "14d" or "12h"). Workspaces that
+	// inherit the project settings use this value.
+	AutoDestroyActivityDuration *string `jsonapi:"attr,auto-destroy-activity-duration,omitempty"`
+
+	// The default execution mode of the workspaces in the project.
+	DefaultExecutionMode *ExecutionModeType `jsonapi:"attr,default-execution-mode,omitempty"`
+
+	// The default agent pool of the workspaces in the project. This is
+	// required when the default execution mode is agent.
+	DefaultAgentPool *AgentPool `jsonapi:"relation,default-agent-pool,omitempty"`
+}
+
+// Validate checks the project create options for errors, without making an API
+// request.
+func (o ProjectCreateOptions) Validate() error {
+	if !validString(o.Name) {
+		return validationError("name is required")
+	}
+	return validProjectDefaults(o.AutoDestroyActivityDuration, o.DefaultExecutionMode, o.DefaultAgentPool)
+}
+
+// validProjectDefaults validates the default settings of a project.
+func validProjectDefaults(duration *string, mode *ExecutionModeType, pool *AgentPool) error {
+	if duration != nil && !validActivityDuration(duration) {
+		return validationError("invalid value for auto destroy activity duration")
+	}
+	if mode != nil && !validExecutionMode(*mode) {
+		return validationError("invalid value for default execution mode")
+	}
+	if pool != nil && !validStringID(&pool.ID) {
+		return validationError("invalid value for default agent pool ID")
+	}
+	if pool != nil && mode != nil && *mode != ExecutionModeAgent {
+		return validationError("default agent pool can only be set when the default execution mode is agent")
+	}
+	return nil
+}
+
+// Create a new project with the given options.
+func (s *projects) Create(ctx context.Context, organization string, options ProjectCreateOptions) (*Project, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("organizations/%s/projects", url.QueryEscape(organization))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Project{}
+	err = s.client.do(ctx, req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Read a project by its ID.
+func (s *projects) Read(ctx context.Context, projectID string) (*Project, error) {
+	if !validStringID(&projectID) {
+		return nil, errors.New("invalid value for project ID")
+	}
+
+	u := fmt.Sprintf("projects/%s", url.QueryEscape(projectID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Project{}
+	err = s.client.do(ctx, req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// ProjectUpdateOptions represents the options for updating a project.
+type ProjectUpdateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,projects"`
+
+	// A new name for the project.
+	Name *string `jsonapi:"attr,name,omitempty"`
+
+	// How long a workspace may be inactive before it is automatically
+	// destroyed, in days or hours (e.g. "14d" or "12h").
+	AutoDestroyActivityDuration *string `jsonapi:"attr,auto-destroy-activity-duration,omitempty"`
+
+	// The default execution mode of the workspaces in the project.
+	DefaultExecutionMode *ExecutionModeType `jsonapi:"attr,default-execution-mode,omitempty"`
+
+	// The default agent pool of the workspaces in the project.
+	DefaultAgentPool *AgentPool `jsonapi:"relation,default-agent-pool,omitempty"`
+}
+
+// Validate checks the project update options for errors, without making an API
+// request.
+func (o ProjectUpdateOptions) Validate() error {
+	if o.Name != nil && !validString(o.Name) {
+		return validationError("invalid value for name")
+	}
+	return validProjectDefaults(o.AutoDestroyActivityDuration, o.DefaultExecutionMode, o.DefaultAgentPool)
+}
+
+// Update a project by its ID.
+func (s *projects) Update(ctx context.Context, projectID string, options ProjectUpdateOptions) (*Project, error) {
+	if !validStringID(&projectID) {
+		return nil, errors.New("invalid value for project ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("projects/%s", url.QueryEscape(projectID))
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Project{}
+	err = s.client.do(ctx, req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Delete a project by its ID.
+func (s *projects) Delete(ctx context.Context, projectID string) error {
+	if !validStringID(&projectID) {
+		return errors.New("invalid value for project ID")
+	}
+
+	u := fmt.Sprintf("projects/%s", url.QueryEscape(projectID))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
@@ -0,0 +1,217 @@
+package tfe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectsList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	pTest1, _ := createProject(t, client, orgTest)
+	pTest2, _ := createProject(t, client, orgTest)
+
+	t.Run("without list options", func(t *testing.T) {
+		pl, err := client.Projects.List(ctx, orgTest.Name, ProjectListOptions{})
+		require.NoError(t, err)
+		assert.Contains(t, pl.Items, pTest1)
+		assert.Contains(t, pl.Items, pTest2)
+		assert.Equal(t, 1, pl.CurrentPage)
+	})
+
+	t.Run("with a name filter", func(t *testing.T) {
+		pl, err := client.Projects.List(ctx, orgTest.Name, ProjectListOptions{
+			Name: String(pTest1.Name),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, pl.Items, pTest1)
+		assert.NotContains(t, pl.Items, pTest2)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		pl, err := client.Projects.List(ctx, badIdentifier, ProjectListOptions{})
+		assert.Nil(t, pl)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
+func TestProjectsCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	t.Run("with valid options", func(t *testing.T) {
+		options := ProjectCreateOptions{
+			Name:                        String(randomString(t)),
+			AutoDestroyActivityDuration: String("14d"),
+			DefaultExecutionMode:        ExecutionMode(ExecutionModeLocal),
+		}
+
+		p, err := client.Projects.Create(ctx, orgTest.Name, options)
+		require.NoError(t, err)
+
+		// Get a refreshed view from the API.
+		refreshed, err := client.Projects.Read(ctx, p.ID)
+		require.NoError(t, err)
+
+		for _, item := range []*Project{
+			p,
+			refreshed,
+		} {
+			assert.NotEmpty(t, item.ID)
+			assert.Equal(t, *options.Name, item.Name)
+			assert.Equal(t, *options.AutoDestroyActivityDuration, item.AutoDestroyActivityDuration)
+			assert.Equal(t, *options.DefaultExecutionMode, item.DefaultExecutionMode)
+		}
+	})
+
+	t.Run("with a default agent pool", func(t *testing.T) {
+		poolTest, poolTestCleanup := createAgentPool(t, client, orgTest)
+		defer poolTestCleanup()
+
+		p, err := client.Projects.Create(ctx, orgTest.Name, ProjectCreateOptions{
+			Name:                 String(randomString(t)),
+			DefaultExecutionMode: ExecutionMode(ExecutionModeAgent),
+			DefaultAgentPool:     poolTest,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, p.DefaultAgentPool)
+		assert.Equal(t, poolTest.ID, p.DefaultAgentPool.ID)
+	})
+
+	t.Run("when options is missing name", func(t *testing.T) {
+		p, err := client.Projects.Create(ctx, orgTest.Name, ProjectCreateOptions{})
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "name is required")
+	})
+
+	t.Run("with an invalid auto destroy activity duration", func(t *testing.T) {
+		p, err := client.Projects.Create(ctx, orgTest.Name, ProjectCreateOptions{
+			Name:                        String(randomString(t)),
+			AutoDestroyActivityDuration: String("2w"),
+		})
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid value for auto destroy activity duration")
+	})
+
+	t.Run("with a default agent pool and a non-agent execution mode", func(t *testing.T) {
+		p, err := client.Projects.Create(ctx, orgTest.Name, ProjectCreateOptions{
+			Name:                 String(randomString(t)),
+			DefaultExecutionMode: ExecutionMode(ExecutionModeRemote),
+			DefaultAgentPool:     &AgentPool{ID: "apool-123"},
+		})
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "default agent pool can only be set when the default execution mode is agent")
+	})
+
+	t.Run("when options has an invalid organization", func(t *testing.T) {
+		p, err := client.Projects.Create(ctx, badIdentifier, ProjectCreateOptions{
+			Name: String(randomString(t)),
+		})
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
+func TestProjectsRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	pTest, pTestCleanup := createProject(t, client, nil)
+	defer pTestCleanup()
+
+	t.Run("when the project exists", func(t *testing.T) {
+		p, err := client.Projects.Read(ctx, pTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, pTest, p)
+	})
+
+	t.Run("when the project does not exist", func(t *testing.T) {
+		p, err := client.Projects.Read(ctx, "nonexisting")
+		assert.Nil(t, p)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid project ID", func(t *testing.T) {
+		p, err := client.Projects.Read(ctx, badIdentifier)
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid value for project ID")
+	})
+}
+
+func TestProjectsUpdate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	pTest, pTestCleanup := createProject(t, client, nil)
+	defer pTestCleanup()
+
+	t.Run("with valid options", func(t *testing.T) {
+		options := ProjectUpdateOptions{
+			Name:                        String(randomString(t)),
+			AutoDestroyActivityDuration: String("12h"),
+		}
+
+		p, err := client.Projects.Update(ctx, pTest.ID, options)
+		require.NoError(t, err)
+
+		// Get a refreshed view from the API.
+		refreshed, err := client.Projects.Read(ctx, pTest.ID)
+		require.NoError(t, err)
+
+		for _, item := range []*Project{
+			p,
+			refreshed,
+		} {
+			assert.Equal(t, *options.Name, item.Name)
+			assert.Equal(t, *options.AutoDestroyActivityDuration, item.AutoDestroyActivityDuration)
+		}
+	})
+
+	t.Run("with an invalid auto destroy activity duration", func(t *testing.T) {
+		p, err := client.Projects.Update(ctx, pTest.ID, ProjectUpdateOptions{
+			AutoDestroyActivityDuration: String("0d"),
+		})
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid value for auto destroy activity duration")
+	})
+
+	t.Run("without a valid project ID", func(t *testing.T) {
+		p, err := client.Projects.Update(ctx, badIdentifier, ProjectUpdateOptions{})
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid value for project ID")
+	})
+}
+
+func TestProjectsDelete(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	pTest, _ := createProject(t, client, orgTest)
+
+	t.Run("with valid options", func(t *testing.T) {
+		err := client.Projects.Delete(ctx, pTest.ID)
+		require.NoError(t, err)
+
+		// Try loading the project - it should fail.
+		_, err = client.Projects.Read(ctx, pTest.ID)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid project ID", func(t *testing.T) {
+		err := client.Projects.Delete(ctx, badIdentifier)
+		assert.EqualError(t, err, "invalid value for project ID")
+	})
+}
@@ -0,0 +1,178 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Refresh re-reads the given resource from the API and updates it in place.
+// The resource must be a non-nil pointer to one of the supported resource
+// types and must carry its ID (or name, in case of an organization). A
+// registry module is read by its organization, name and provider, and a
+// workspace run task must carry its workspace as well.
+//
+// This is useful when polling for changes, for example:
+//
+//	for run.Status != tfe.RunApplied {
+//		time.Sleep(5 * time.Second)
+//		if err := client.Refresh(ctx, run); err != nil {
+//			return err
+//		}
+//	}
+func (c *Client) Refresh(ctx context.Context, resource interface{}) error {
+	var fresh interface{}
+	var err error
+
+	switch r := resource.(type) {
+	case *AdminTerraformVersion:
+		if r != nil {
+			fresh, err = c.AdminTerraformVersions.Read(ctx, r.ID)
+		}
+	case *Agent:
+		if r != nil {
+			fresh, err = c.Agents.Read(ctx, r.ID)
+		}
+	case *AgentPool:
+		if r != nil {
+			fresh, err = c.AgentPools.Read(ctx, r.ID)
+		}
+	case *AgentToken:
+		if r != nil {
+			fresh, err = c.AgentTokens.Read(ctx, r.ID)
+		}
+	case *Apply:
+		if r != nil {
+			fresh, err = c.Applies.Read(ctx, r.ID)
+		}
+	case *AssessmentResult:
+		if r != nil {
+			fresh, err = c.AssessmentResults.Read(ctx, r.ID)
+		}
+	case *ConfigurationVersion:
+		if r != nil {
+			fresh, err = c.ConfigurationVersions.Read(ctx, r.ID)
+		}
+	case *CostEstimate:
+		if r != nil {
+			fresh, err = c.CostEstimates.Read(ctx, r.ID)
+		}
+	case *NotificationConfiguration:
+		if r != nil {
+			fresh, err = c.NotificationConfigurations.Read(ctx, r.ID)
+		}
+	case *OAuthClient:
+		if r != nil {
+			fresh, err = c.OAuthClients.Read(ctx, r.ID)
+		}
+	case *OAuthToken:
+		if r != nil {
+			fresh, err = c.OAuthTokens.Read(ctx, r.ID)
+		}
+	case *Organization:
+		if r != nil {
+			fresh, err = c.Organizations.Read(ctx, r.Name)
+		}
+	case *OrganizationMembership:
+		if r != nil {
+			fresh, err = c.OrganizationMemberships.Read(ctx, r.ID)
+		}
+	case *Plan:
+		if r != nil {
+			fresh, err = c.Plans.Read(ctx, r.ID)
+		}
+	case *PlanExport:
+		if r != nil {
+			fresh, err = c.PlanExports.Read(ctx, r.ID)
+		}
+	case *Policy:
+		if r != nil {
+			fresh, err = c.Policies.Read(ctx, r.ID)
+		}
+	case *PolicyCheck:
+		if r != nil {
+			fresh, err = c.PolicyChecks.Read(ctx, r.ID)
+		}
+	case *PolicySet:
+		if r != nil {
+			fresh, err = c.PolicySets.Read(ctx, r.ID)
+		}
+	case *PolicySetOutcome:
+		if r != nil {
+			fresh, err = c.PolicyEvaluations.ReadPolicySetOutcome(ctx, r.ID)
+		}
+	case *Project:
+		if r != nil {
+			fresh, err = c.Projects.Read(ctx, r.ID)
+		}
+	case *RegistryModule:
+		if r != nil {
+			var organization string
+			if r.Organization != nil {
+				organization = r.Organization.Name
+			}
+			fresh, err = c.RegistryModules.Read(ctx, organization, r.Name, r.Provider)
+		}
+	case *Run:
+		if r != nil {
+			fresh, err = c.Runs.Read(ctx, r.ID)
+		}
+	case *RunEvent:
+		if r != nil {
+			fresh, err = c.RunEvents.Read(ctx, r.ID)
+		}
+	case *RunTask:
+		if r != nil {
+			fresh, err = c.RunTasks.Read(ctx, r.ID)
+		}
+	case *RunTrigger:
+		if r != nil {
+			fresh, err = c.RunTriggers.Read(ctx, r.ID)
+		}
+	case *SSHKey:
+		if r != nil {
+			fresh, err = c.SSHKeys.Read(ctx, r.ID)
+		}
+	case *StateVersion:
+		if r != nil {
+			fresh, err = c.StateVersions.Read(ctx, r.ID)
+		}
+	case *StateVersionOutput:
+		if r != nil {
+			fresh, err = c.StateVersionOutputs.Read(ctx, r.ID)
+		}
+	case *Team:
+		if r != nil {
+			fresh, err = c.Teams.Read(ctx, r.ID)
+		}
+	case *TeamAccess:
+		if r != nil {
+			fresh, err = c.TeamAccess.Read(ctx, r.ID)
+		}
+	case *Workspace:
+		if r != nil {
+			fresh, err = c.Workspaces.ReadByID(ctx, r.ID)
+		}
+	case *WorkspaceRunTask:
+		if r != nil {
+			if r.Workspace == nil {
+				return errors.New("workspace of the workspace run task is required")
+			}
+			fresh, err = c.WorkspaceRunTasks.Read(ctx, r.Workspace.ID, r.ID)
+		}
+	default:
+		return fmt.Errorf("unsupported resource type %T", resource)
+	}
+	if err != nil {
+		return err
+	}
+	if fresh == nil {
+		return fmt.Errorf("resource of type %T is nil", resource)
+	}
+
+	// Update the given resource in place.
+	reflect.ValueOf(resource).Elem().Set(reflect.ValueOf(fresh).Elem())
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_refresh(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/runs/run-123456789":
+			w.Write([]byte(`{"data":{"id":"run-123456789","type":"runs","attributes":{"status":"applied"}}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("with a supported resource", func(t *testing.T) {
+		r := &Run{ID: "run-123456789", Status: RunPending}
+		err := client.Refresh(ctx, r)
+		require.NoError(t, err)
+		assert.Equal(t, "run-123456789", r.ID)
+		assert.Equal(t, RunApplied, r.Status)
+	})
+
+	t.Run("when the resource does not exist", func(t *testing.T) {
+		r := &Run{ID: "run-nonexisting", Status: RunPending}
+		err := client.Refresh(ctx, r)
+		assert.Equal(t, ErrResourceNotFound, err)
+		assert.Equal(t, RunPending, r.Status)
+	})
+
+	t.Run("with a nil resource", func(t *testing.T) {
+		var r *Run
+		err := client.Refresh(ctx, r)
+		assert.EqualError(t, err, "resource of type *tfe.Run is nil")
+	})
+
+	t.Run("with an unsupported resource", func(t *testing.T) {
+		err := client.Refresh(ctx, &User{ID: "user-123456789"})
+		assert.EqualError(t, err, "unsupported resource type *tfe.User")
+	})
+
+	t.Run("with a workspace run task without a workspace", func(t *testing.T) {
+		err := client.Refresh(ctx, &WorkspaceRunTask{ID: "wstask-123456789"})
+		assert.EqualError(t, err, "workspace of the workspace run task is required")
+	})
+
+	t.Run("with every resource that can be read by its ID", func(t *testing.T) {
+		// The tokens are read by their organization or team, not by their ID.
+		skip := map[string]bool{
+			"OrganizationTokens": true,
+			"TeamTokens":         true,
+		}
+
+		ctxType := reflect.TypeOf((*context.Context)(nil)).Elem()
+		clientType := reflect.TypeOf(*client)
+		for i := 0; i < clientType.NumField(); i++ {
+			field := clientType.Field(i)
+			if field.Type.Kind() != reflect.Interface || skip[field.Name] {
+				continue
+			}
+
+			for j := 0; j < field.Type.NumMethod(); j++ {
+				m := field.Type.Method(j)
+				if !strings.HasPrefix(m.Name, "Read") || m.Type.NumIn() != 2 || m.Type.NumOut() != 2 ||
+					m.Type.In(0) != ctxType || m.Type.In(1).Kind() != reflect.String ||
+					m.Type.Out(0).Kind() != reflect.Ptr || m.Type.Out(0).Elem().Kind() != reflect.Struct {
+					continue
+				}
+
+				resource := reflect.New(m.Type.Out(0).Elem()).Interface()
+				err := client.Refresh(ctx, resource)
+				if err != nil && strings.HasPrefix(err.Error(), "unsupported resource type") {
+					t.Errorf("%s.%s: %v", field.Name, m.Name, err)
+				}
+			}
+		}
+	})
+}
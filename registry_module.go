@@ -0,0 +1,247 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ RegistryModules = (*registryModules)(nil)
+
+// RegistryModules describes all the registry module related methods that the
+// Terraform Enterprise API supports.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/enterprise/api/modules.html
+type RegistryModules interface {
+	// List all the registry modules of the given organization.
+	List(ctx context.Context, organization string, options RegistryModuleListOptions) (*RegistryModuleList, error)
+
+	// Publish a new registry module from a VCS repository.
+	CreateWithVCSConnection(ctx context.Context, options RegistryModuleCreateWithVCSConnectionOptions) (*RegistryModule, error)
+
+	// Read a registry module by its organization, name and provider.
+	Read(ctx context.Context, organization, name, provider string) (*RegistryModule, error)
+
+	// Delete a registry module, including all of its providers and versions.
+	Delete(ctx context.Context, organization, name string) error
+}
+
+// registryModules implements RegistryModules.
+type registryModules struct {
+	client *Client
+}
+
+// RegistryModuleStatus represents the status of a registry module.
+type RegistryModuleStatus string
+
+// List of available registry module statuses.
+const (
+	RegistryModuleStatusPending       RegistryModuleStatus = "pending"
+	RegistryModuleStatusNoVersionTags RegistryModuleStatus = "no_version_tags"
+	RegistryModuleStatusSetupFailed   RegistryModuleStatus = "setup_failed"
+	RegistryModuleStatusSetupComplete RegistryModuleStatus = "setup_complete"
+)
+
+// RegistryModuleVersionStatus represents the status of a registry module
+// version.
+type RegistryModuleVersionStatus string
+
+// List of available registry module version statuses.
+const (
+	RegistryModuleVersionStatusPending             RegistryModuleVersionStatus = "pending"
+	RegistryModuleVersionStatusCloning             RegistryModuleVersionStatus = "cloning"
+	RegistryModuleVersionStatusCloneFailed         RegistryModuleVersionStatus = "clone_failed"
+	RegistryModuleVersionStatusRegIngressReqFailed RegistryModuleVersionStatus = "reg_ingress_req_failed"
+	RegistryModuleVersionStatusRegIngressing       RegistryModuleVersionStatus = "reg_ingressing"
+	RegistryModuleVersionStatusRegIngressFailed    RegistryModuleVersionStatus = "reg_ingress_failed"
+	RegistryModuleVersionStatusOk                  RegistryModuleVersionStatus = "ok"
+)
+
+// RegistryModuleList represents a list of registry modules.
+type RegistryModuleList struct {
+	*Pagination
+	Items []*RegistryModule
+}
+
+// RegistryModule represents a Terraform Enterprise registry module.
+type RegistryModule struct {
+	ID              string                          `jsonapi:"primary,registry-modules"`
+	Name            string                          `jsonapi:"attr,name"`
+	Provider        string                          `jsonapi:"attr,provider"`
+	Permissions     *RegistryModulePermissions      `jsonapi:"attr,permissions"`
+	Status          RegistryModuleStatus            `jsonapi:"attr,status"`
+	VCSRepo         *VCSRepo                        `jsonapi:"attr,vcs-repo"`
+	VersionStatuses []RegistryModuleVersionStatuses `jsonapi:"attr,version-statuses"`
+	CreatedAt       time.Time                       `jsonapi:"attr,created-at,iso8601"`
+	UpdatedAt       time.Time                       `jsonapi:"attr,updated-at,iso8601"`
+
+	// Relations
+	Organization *Organization `jsonapi:"relation,organization"`
+}
+
+// RegistryModulePermissions represents the permissions of a registry module.
+type RegistryModulePermissions struct {
+	CanDelete bool `json:"can-delete"`
+	CanResync bool `json:"can-resync"`
+	CanRetry  bool `json:"can-retry"`
+}
+
+// RegistryModuleVersionStatuses represents the status of a single published
+// version of a registry module.
+type RegistryModuleVersionStatuses struct {
+	Version string                      `json:"version"`
+	Status  RegistryModuleVersionStatus `json:"status"`
+	Error   string                      `json:"error"`
+}
+
+// RegistryModuleListOptions represents the options for listing registry
+// modules.
+type RegistryModuleListOptions struct {
+	ListOptions
+}
+
+// List all the registry modules of the given organization.
+func (s *registryModules) List(ctx context.Context, organization string, options RegistryModuleListOptions) (*RegistryModuleList, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s/registry-modules", url.QueryEscape(organization))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rml := &RegistryModuleList{}
+	err = s.client.do(ctx, req, rml)
+	if err != nil {
+		return nil, err
+	}
+
+	return rml, nil
+}
+
+// RegistryModuleCreateWithVCSConnectionOptions represents the options for
+// publishing a registry module from a VCS repository.
+type RegistryModuleCreateWithVCSConnectionOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,registry-modules"`
+
+	// The VCS repository to publish the module from.
+	VCSRepo *RegistryModuleVCSRepoOptions `jsonapi:"attr,vcs-repo"`
+}
+
+// RegistryModuleVCSRepoOptions represents the VCS repository of a registry
+// module to publish.
+type RegistryModuleVCSRepoOptions struct {
+	// The repository in the format :org/:repo, which must be named
+	// terraform-:provider-:name.
+	Identifier *string `json:"identifier,omitempty"`
+
+	// The ID of the OAuth token used to access the repository.
+	OAuthTokenID *string `json:"oauth-token-id,omitempty"`
+
+	// The repository as displayed to users, defaults to the identifier.
+	DisplayIdentifier *string `json:"display-identifier,omitempty"`
+}
+
+// Validate checks the registry module create options for errors, without
+// making an API request.
+func (o RegistryModuleCreateWithVCSConnectionOptions) Validate() error {
+	if o.VCSRepo == nil {
+		return validationError("vcs repo is required")
+	}
+	if !validString(o.VCSRepo.Identifier) {
+		return validationError("identifier is required")
+	}
+	if !validString(o.VCSRepo.OAuthTokenID) {
+		return validationError("oauth token ID is required")
+	}
+	return nil
+}
+
+// CreateWithVCSConnection publishes a new registry module from a VCS
+// repository. Its versions are published from the tags of the repository.
+func (s *registryModules) CreateWithVCSConnection(ctx context.Context, options RegistryModuleCreateWithVCSConnectionOptions) (*RegistryModule, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	req, err := s.client.newRequest("POST", "registry-modules", &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rm := &RegistryModule{}
+	err = s.client.do(ctx, req, rm)
+	if err != nil {
+		return nil, err
+	}
+
+	return rm, nil
+}
+
+// Read a registry module by its organization, name and provider. The
+// response includes the statuses of all the published versions.
+func (s *registryModules) Read(ctx context.Context, organization, name, provider string) (*RegistryModule, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+	if !validStringID(&name) {
+		return nil, errors.New("invalid value for name")
+	}
+	if !validStringID(&provider) {
+		return nil, errors.New("invalid value for provider")
+	}
+
+	u := fmt.Sprintf(
+		"registry-modules/show/%s/%s/%s",
+		url.QueryEscape(organization),
+		url.QueryEscape(name),
+		url.QueryEscape(provider),
+	)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rm := &RegistryModule{}
+	err = s.client.do(ctx, req, rm)
+	if err != nil {
+		return nil, err
+	}
+
+	return rm, nil
+}
+
+// Delete a registry module, including all of its providers and versions.
+func (s *registryModules) Delete(ctx context.Context, organization, name string) error {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return err
+	}
+	if !validStringID(&name) {
+		return errors.New("invalid value for name")
+	}
+
+	u := fmt.Sprintf(
+		"registry-modules/actions/delete/%s/%s",
+		url.QueryEscape(organization),
+		url.QueryEscape(name),
+	)
+	req, err := s.client.newRequest("POST", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
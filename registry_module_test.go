@@ -0,0 +1,228 @@
+package tfe
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryModulesList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	rmTest, rmTestCleanup := createRegistryModule(t, client, orgTest)
+	defer rmTestCleanup()
+
+	t.Run("with no list options", func(t *testing.T) {
+		rml, err := client.RegistryModules.List(ctx, orgTest.Name, RegistryModuleListOptions{})
+		require.NoError(t, err)
+		require.Len(t, rml.Items, 1)
+		assert.Equal(t, rmTest.ID, rml.Items[0].ID)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		rml, err := client.RegistryModules.List(ctx, badIdentifier, RegistryModuleListOptions{})
+		assert.Nil(t, rml)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
+func TestRegistryModulesCreateWithVCSConnection(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("with valid options", func(t *testing.T) {
+		rmTest, rmTestCleanup := createRegistryModule(t, client, nil)
+		defer rmTestCleanup()
+
+		assert.NotEmpty(t, rmTest.ID)
+		assert.NotEmpty(t, rmTest.Name)
+		assert.NotEmpty(t, rmTest.Provider)
+		require.NotNil(t, rmTest.VCSRepo)
+		assert.NotEmpty(t, rmTest.VCSRepo.OAuthTokenID)
+	})
+
+	t.Run("without a vcs repo", func(t *testing.T) {
+		rm, err := client.RegistryModules.CreateWithVCSConnection(ctx, RegistryModuleCreateWithVCSConnectionOptions{})
+		assert.Nil(t, rm)
+		assert.EqualError(t, err, "vcs repo is required")
+	})
+
+	t.Run("without an identifier", func(t *testing.T) {
+		rm, err := client.RegistryModules.CreateWithVCSConnection(ctx, RegistryModuleCreateWithVCSConnectionOptions{
+			VCSRepo: &RegistryModuleVCSRepoOptions{
+				OAuthTokenID: String("ot-123"),
+			},
+		})
+		assert.Nil(t, rm)
+		assert.EqualError(t, err, "identifier is required")
+	})
+
+	t.Run("without an oauth token ID", func(t *testing.T) {
+		rm, err := client.RegistryModules.CreateWithVCSConnection(ctx, RegistryModuleCreateWithVCSConnectionOptions{
+			VCSRepo: &RegistryModuleVCSRepoOptions{
+				Identifier: String("acme/terraform-aws-vpc"),
+			},
+		})
+		assert.Nil(t, rm)
+		assert.EqualError(t, err, "oauth token ID is required")
+	})
+}
+
+func TestRegistryModulesRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	rmTest, rmTestCleanup := createRegistryModule(t, client, orgTest)
+	defer rmTestCleanup()
+
+	t.Run("when the registry module exists", func(t *testing.T) {
+		rm, err := client.RegistryModules.Read(ctx, orgTest.Name, rmTest.Name, rmTest.Provider)
+		require.NoError(t, err)
+		assert.Equal(t, rmTest.ID, rm.ID)
+		require.NotNil(t, rm.Permissions)
+		assert.True(t, rm.Permissions.CanDelete)
+	})
+
+	t.Run("when the registry module does not exist", func(t *testing.T) {
+		rm, err := client.RegistryModules.Read(ctx, orgTest.Name, "nonexisting", "nonexisting")
+		assert.Nil(t, rm)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid name", func(t *testing.T) {
+		rm, err := client.RegistryModules.Read(ctx, orgTest.Name, badIdentifier, rmTest.Provider)
+		assert.Nil(t, rm)
+		assert.EqualError(t, err, "invalid value for name")
+	})
+
+	t.Run("without a valid provider", func(t *testing.T) {
+		rm, err := client.RegistryModules.Read(ctx, orgTest.Name, rmTest.Name, badIdentifier)
+		assert.Nil(t, rm)
+		assert.EqualError(t, err, "invalid value for provider")
+	})
+}
+
+func TestRegistryModulesDelete(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		err := client.RegistryModules.Delete(ctx, badIdentifier, "vpc")
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+
+	t.Run("without a valid name", func(t *testing.T) {
+		err := client.RegistryModules.Delete(ctx, orgTest.Name, badIdentifier)
+		assert.EqualError(t, err, "invalid value for name")
+	})
+}
+
+func TestRegistryModulesRequests(t *testing.T) {
+	var method, path, body string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+
+		switch r.URL.Path {
+		case "/api/tfe/v2/organizations/acme/registry-modules":
+			w.Write([]byte(`{"data": [{"id": "mod-123", "type": "registry-modules", "attributes": {
+				"name": "vpc", "provider": "aws", "status": "setup_complete"}}],
+				"meta": {"pagination": {"current-page": 1, "total-count": 1}}}`))
+		case "/api/tfe/v2/registry-modules":
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "mod-123", "type": "registry-modules", "attributes": {
+				"name": "vpc", "provider": "aws", "status": "pending"}}}`))
+		case "/api/tfe/v2/registry-modules/show/acme/vpc/aws":
+			w.Write([]byte(`{"data": {"id": "mod-123", "type": "registry-modules", "attributes": {
+				"name": "vpc", "provider": "aws", "status": "setup_complete",
+				"vcs-repo": {"identifier": "acme/terraform-aws-vpc", "oauth-token-id": "ot-123"},
+				"version-statuses": [
+					{"version": "1.1.0", "status": "ok"},
+					{"version": "1.0.0", "status": "reg_ingress_failed", "error": "invalid module"}]},
+				"relationships": {"organization": {"data": {"id": "acme", "type": "organizations"}}}}}`))
+		case "/api/tfe/v2/registry-modules/actions/delete/acme/vpc":
+			w.WriteHeader(204)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when listing the registry modules", func(t *testing.T) {
+		rml, err := client.RegistryModules.List(ctx, "acme", RegistryModuleListOptions{})
+		require.NoError(t, err)
+		require.Len(t, rml.Items, 1)
+		assert.Equal(t, "vpc", rml.Items[0].Name)
+		assert.Equal(t, RegistryModuleStatusSetupComplete, rml.Items[0].Status)
+	})
+
+	t.Run("when publishing a registry module", func(t *testing.T) {
+		rm, err := client.RegistryModules.CreateWithVCSConnection(ctx, RegistryModuleCreateWithVCSConnectionOptions{
+			VCSRepo: &RegistryModuleVCSRepoOptions{
+				Identifier:   String("acme/terraform-aws-vpc"),
+				OAuthTokenID: String("ot-123"),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "mod-123", rm.ID)
+		assert.Equal(t, "POST", method)
+		assert.Contains(t, body, `"vcs-repo":{"identifier":"acme/terraform-aws-vpc","oauth-token-id":"ot-123"}`)
+	})
+
+	t.Run("when reading a registry module", func(t *testing.T) {
+		rm, err := client.RegistryModules.Read(ctx, "acme", "vpc", "aws")
+		require.NoError(t, err)
+		assert.Equal(t, "aws", rm.Provider)
+		require.NotNil(t, rm.VCSRepo)
+		assert.Equal(t, "acme/terraform-aws-vpc", rm.VCSRepo.Identifier)
+		assert.Equal(t, []RegistryModuleVersionStatuses{
+			{Version: "1.1.0", Status: RegistryModuleVersionStatusOk},
+			{Version: "1.0.0", Status: RegistryModuleVersionStatusRegIngressFailed, Error: "invalid module"},
+		}, rm.VersionStatuses)
+		require.NotNil(t, rm.Organization)
+		assert.Equal(t, "acme", rm.Organization.Name)
+	})
+
+	t.Run("when deleting a registry module", func(t *testing.T) {
+		err := client.RegistryModules.Delete(ctx, "acme", "vpc")
+		require.NoError(t, err)
+		assert.Equal(t, "POST", method)
+		assert.Equal(t, "/api/tfe/v2/registry-modules/actions/delete/acme/vpc", path)
+	})
+
+	t.Run("with the default organization", func(t *testing.T) {
+		client := client.WithOrganization("acme")
+
+		_, err := client.RegistryModules.List(ctx, "", RegistryModuleListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "/api/tfe/v2/organizations/acme/registry-modules", path)
+
+		_, err = client.RegistryModules.Read(ctx, "", "vpc", "aws")
+		require.NoError(t, err)
+		assert.Equal(t, "/api/tfe/v2/registry-modules/show/acme/vpc/aws", path)
+
+		err = client.RegistryModules.Delete(ctx, "", "vpc")
+		require.NoError(t, err)
+		assert.Equal(t, "/api/tfe/v2/registry-modules/actions/delete/acme/vpc", path)
+	})
+
+	t.Run("without an organization", func(t *testing.T) {
+		rml, err := client.RegistryModules.List(ctx, "", RegistryModuleListOptions{})
+		assert.Nil(t, rml)
+		assert.EqualError(t, err, "organization is required")
+	})
+}
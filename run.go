@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"sync"
 	"time"
 )
 
@@ -22,9 +23,24 @@ type Runs interface {
 	// Create a new run with the given options.
 	Create(ctx context.Context, options RunCreateOptions) (*Run, error)
 
+	// CreateFromLatestConfiguration creates a new run in the given workspace
+	// using its most recently uploaded configuration version that isn't
+	// speculative.
+	CreateFromLatestConfiguration(ctx context.Context, workspaceID string, options RunCreateOptions) (*Run, error)
+
 	// Read a run by its ID.
 	Read(ctx context.Context, runID string) (*Run, error)
 
+	// ReadWithOptions reads a run by its ID using the given options.
+	ReadWithOptions(ctx context.Context, runID string, options RunReadOptions) (*Run, error)
+
+	// ReadByIDs concurrently reads the runs with the given IDs.
+	ReadByIDs(ctx context.Context, runIDs []string, options BatchReadOptions) (map[string]*Run, error)
+
+	// Execution reads the plan, apply and cost estimate of a run by its ID,
+	// and returns a summary of the execution of the run.
+	Execution(ctx context.Context, runID string) (*RunExecution, error)
+
 	// Apply a run by its ID.
 	Apply(ctx context.Context, runID string, options RunApplyOptions) error
 
@@ -75,9 +91,21 @@ type RunSource string
 const (
 	RunSourceAPI                  RunSource = "tfe-api"
 	RunSourceConfigurationVersion RunSource = "tfe-configuration-version"
+	RunSourceRunTrigger           RunSource = "tfe-run-trigger"
 	RunSourceUI                   RunSource = "tfe-ui"
 )
 
+// RunApplyReason represents the reason a run was applied.
+type RunApplyReason string
+
+// List all available run apply reasons.
+const (
+	RunApplyReasonNone                RunApplyReason = ""
+	RunApplyReasonManual              RunApplyReason = "manual"
+	RunApplyReasonRunTriggerAutoApply RunApplyReason = "run-trigger-auto-apply"
+	RunApplyReasonWorkspaceAutoApply  RunApplyReason = "workspace-auto-apply"
+)
+
 // RunList represents a list of runs.
 type RunList struct {
 	*Pagination
@@ -88,6 +116,7 @@ type RunList struct {
 type Run struct {
 	ID                     string               `jsonapi:"primary,runs"`
 	Actions                *RunActions          `jsonapi:"attr,actions"`
+	AutoApply              bool                 `jsonapi:"attr,auto-apply"`
 	CreatedAt              time.Time            `jsonapi:"attr,created-at,iso8601"`
 	ForceCancelAvailableAt time.Time            `jsonapi:"attr,force-cancel-available-at,iso8601"`
 	HasChanges             bool                 `jsonapi:"attr,has-changes"`
@@ -102,12 +131,85 @@ type Run struct {
 	// Relations
 	Apply                *Apply                `jsonapi:"relation,apply"`
 	ConfigurationVersion *ConfigurationVersion `jsonapi:"relation,configuration-version"`
+	ConfirmedBy          *User                 `jsonapi:"relation,confirmed-by"`
 	CostEstimate         *CostEstimate         `jsonapi:"relation,cost-estimate"`
+	CreatedBy            *User                 `jsonapi:"relation,created-by"`
 	Plan                 *Plan                 `jsonapi:"relation,plan"`
 	PolicyChecks         []*PolicyCheck        `jsonapi:"relation,policy-checks"`
 	Workspace            *Workspace            `jsonapi:"relation,workspace"`
 }
 
+// ApplyReason returns why the run was, or will be, applied. A run that was
+// confirmed by a user is applied manually, regardless of the auto-apply
+// setting. Otherwise an auto-applied run is applied because of the
+// auto-apply setting of its workspace, or of the run trigger that queued it.
+// It returns RunApplyReasonNone for runs that are awaiting confirmation.
+func (r *Run) ApplyReason() RunApplyReason {
+	switch {
+	case r.ConfirmedBy != nil:
+		return RunApplyReasonManual
+	case !r.AutoApply:
+		return RunApplyReasonNone
+	case r.Source == RunSourceRunTrigger:
+		return RunApplyReasonRunTriggerAutoApply
+	default:
+		return RunApplyReasonWorkspaceAutoApply
+	}
+}
+
+// IngressAttributes returns the VCS details of the commit the run was
+// triggered by. It returns nil unless the run was read with its
+// configuration version and ingress attributes included, or when the run
+// was not triggered from a VCS.
+func (r *Run) IngressAttributes() *IngressAttributes {
+	if r.ConfigurationVersion == nil {
+		return nil
+	}
+	return r.ConfigurationVersion.IngressAttributes
+}
+
+// RunExecution represents a summary of the execution of a run, combining the
+// details of the run with those of its plan, apply and cost estimate.
+type RunExecution struct {
+	RunID      string
+	Status     RunStatus
+	HasChanges bool
+	IsDestroy  bool
+	CreatedAt  time.Time
+
+	// FinishedAt is zero until the run reached a final status.
+	FinishedAt time.Time
+
+	// The plan details are empty when the run has no plan yet.
+	PlanStatus               PlanStatus
+	PlanResourceAdditions    int
+	PlanResourceChanges      int
+	PlanResourceDestructions int
+	PlanStartedAt            time.Time
+	PlanFinishedAt           time.Time
+
+	// The apply details are empty when the run has not been applied.
+	ApplyStatus               ApplyStatus
+	ApplyResourceAdditions    int
+	ApplyResourceChanges      int
+	ApplyResourceDestructions int
+	ApplyStartedAt            time.Time
+	ApplyFinishedAt           time.Time
+
+	// The cost estimate delta is nil when the run has no finished cost
+	// estimate, for example because cost estimation is not enabled.
+	CostEstimateDelta *CostEstimateDelta
+}
+
+// Duration returns how long the run took from its creation until it reached a
+// final status. It returns zero while the run is still in progress.
+func (e *RunExecution) Duration() time.Duration {
+	if e.FinishedAt.IsZero() {
+		return 0
+	}
+	return e.FinishedAt.Sub(e.CreatedAt)
+}
+
 // RunActions represents the run actions.
 type RunActions struct {
 	IsCancelable      bool `json:"is-cancelable"`
@@ -142,6 +244,22 @@ type RunStatusTimestamps struct {
 // RunListOptions represents the options for listing runs.
 type RunListOptions struct {
 	ListOptions
+
+	// Only list the runs created by the user with the given username.
+	User *string `url:"search[user],omitempty"`
+
+	// A list of relations to include, e.g. "created_by" to get the details
+	// of the users who created the runs.
+	Include string `url:"include,omitempty"`
+}
+
+// Validate checks the run list options for errors, without making an API
+// request.
+func (o RunListOptions) Validate() error {
+	if o.User != nil && !validString(o.User) {
+		return validationError("invalid value for user")
+	}
+	return nil
 }
 
 // List all the runs of the given workspace.
@@ -149,6 +267,9 @@ func (s *runs) List(ctx context.Context, workspaceID string, options RunListOpti
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
 
 	u := fmt.Sprintf("workspaces/%s/runs", url.QueryEscape(workspaceID))
 	req, err := s.client.newRequest("GET", u, &options)
@@ -174,6 +295,10 @@ type RunCreateOptions struct {
 	// provisioned resources.
 	IsDestroy *bool `jsonapi:"attr,is-destroy,omitempty"`
 
+	// Specifies if the run should be applied automatically when the plan
+	// succeeds. Defaults to the auto-apply setting of the workspace.
+	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
+
 	// Specifies the message to be associated with this run.
 	Message *string `jsonapi:"attr,message,omitempty"`
 
@@ -186,16 +311,18 @@ type RunCreateOptions struct {
 	Workspace *Workspace `jsonapi:"relation,workspace"`
 }
 
-func (o RunCreateOptions) valid() error {
+// Validate checks the run create options for errors, without making an API
+// request.
+func (o RunCreateOptions) Validate() error {
 	if o.Workspace == nil {
-		return errors.New("workspace is required")
+		return validationError("workspace is required")
 	}
 	return nil
 }
 
 // Create a new run with the given options.
 func (s *runs) Create(ctx context.Context, options RunCreateOptions) (*Run, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -216,14 +343,96 @@ func (s *runs) Create(ctx context.Context, options RunCreateOptions) (*Run, erro
 	return r, nil
 }
 
+// CreateFromLatestConfiguration creates a new run in the given workspace using
+// its most recently uploaded configuration version, leaving out speculative
+// configuration versions, which can only be used for plans. The workspace and
+// configuration version of the options are set by this method. It returns
+// ErrNoConfigurationVersion if no configuration has been uploaded yet.
+func (s *runs) CreateFromLatestConfiguration(ctx context.Context, workspaceID string, options RunCreateOptions) (*Run, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+
+	cv, err := s.latestConfigurationVersion(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	options.ConfigurationVersion = cv
+	options.Workspace = &Workspace{ID: workspaceID}
+
+	return s.Create(ctx, options)
+}
+
+// latestConfigurationVersionPages is the number of pages of configuration
+// versions that are searched when the current configuration version of a
+// workspace can't be used.
+const latestConfigurationVersionPages = 3
+
+// latestConfigurationVersion returns the most recently uploaded configuration
+// version of a workspace that isn't speculative. That is the current
+// configuration version of the workspace, unless it is speculative or not
+// uploaded yet, in which case the 300 most recent configuration versions are
+// searched (most recent first), which takes at most 3 requests. It returns
+// ErrNoConfigurationVersion when none of them can be used.
+func (s *runs) latestConfigurationVersion(ctx context.Context, workspaceID string) (*ConfigurationVersion, error) {
+	w, err := s.client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if w.CurrentConfigurationVersion != nil {
+		cv, err := s.client.ConfigurationVersions.Read(ctx, w.CurrentConfigurationVersion.ID)
+		if err != nil {
+			return nil, err
+		}
+		if cv.Status == ConfigurationUploaded && !cv.Speculative {
+			return cv, nil
+		}
+	}
+
+	for pageNumber := 1; pageNumber <= latestConfigurationVersionPages; pageNumber++ {
+		cvl, err := s.client.ConfigurationVersions.List(ctx, workspaceID, ConfigurationVersionListOptions{
+			ListOptions: ListOptions{PageNumber: pageNumber, PageSize: 100},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cv := range cvl.Items {
+			if cv.Status == ConfigurationUploaded && !cv.Speculative {
+				return cv, nil
+			}
+		}
+
+		if cvl.Pagination == nil || cvl.NextPage <= pageNumber {
+			break
+		}
+	}
+
+	return nil, ErrNoConfigurationVersion
+}
+
+// RunReadOptions represents the options for reading a run.
+type RunReadOptions struct {
+	// A list of relations to include, e.g. "configuration_version.ingress_attributes"
+	// to get the VCS commit details of the run with a single request.
+	Include string `url:"include,omitempty"`
+}
+
 // Read a run by its ID.
 func (s *runs) Read(ctx context.Context, runID string) (*Run, error) {
+	return s.ReadWithOptions(ctx, runID, RunReadOptions{})
+}
+
+// ReadWithOptions reads a run by its ID using the given options.
+func (s *runs) ReadWithOptions(ctx context.Context, runID string, options RunReadOptions) (*Run, error) {
 	if !validStringID(&runID) {
 		return nil, errors.New("invalid value for run ID")
 	}
 
 	u := fmt.Sprintf("runs/%s", url.QueryEscape(runID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -243,7 +452,108 @@ type RunApplyOptions struct {
 	Comment *string `json:"comment,omitempty"`
 }
 
-// Apply a run by its ID.
+// ReadByIDs concurrently reads the runs with the given IDs and returns them
+// keyed by their ID. When some of the runs could not be read, the runs that
+// were read are returned together with a *BatchError holding the error of
+// each run that failed, which matches the context error when the context was
+// canceled before all runs were read.
+func (s *runs) ReadByIDs(ctx context.Context, runIDs []string, options BatchReadOptions) (map[string]*Run, error) {
+	var mu sync.Mutex
+	result := make(map[string]*Run, len(runIDs))
+
+	err := readByIDs(ctx, runIDs, options, func(ctx context.Context, id string) error {
+		r, err := s.Read(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		result[id] = r
+		mu.Unlock()
+
+		return nil
+	})
+
+	return result, err
+}
+
+// Execution reads the plan, apply and cost estimate of a run by its ID, and
+// returns a summary of the execution of the run.
+func (s *runs) Execution(ctx context.Context, runID string) (*RunExecution, error) {
+	r, err := s.ReadWithOptions(ctx, runID, RunReadOptions{Include: "plan,apply,cost_estimate"})
+	if err != nil {
+		return nil, err
+	}
+
+	e := &RunExecution{
+		RunID:      r.ID,
+		Status:     r.Status,
+		HasChanges: r.HasChanges,
+		IsDestroy:  r.IsDestroy,
+		CreatedAt:  r.CreatedAt,
+	}
+
+	if p := r.Plan; p != nil {
+		e.PlanStatus = p.Status
+		e.PlanResourceAdditions = p.ResourceAdditions
+		e.PlanResourceChanges = p.ResourceChanges
+		e.PlanResourceDestructions = p.ResourceDestructions
+		if ts := p.StatusTimestamps; ts != nil {
+			e.PlanStartedAt = ts.StartedAt
+			e.PlanFinishedAt = firstTime(ts.FinishedAt, ts.ErroredAt, ts.CanceledAt, ts.ForceCanceledAt)
+		}
+	}
+
+	// The apply of a run exists before it is applied, so only take it into
+	// account when the run was actually applied, or is being applied.
+	if a := r.Apply; a != nil && a.Status != "" && a.Status != ApplyPending && a.Status != ApplyUnreachable {
+		e.ApplyStatus = a.Status
+		e.ApplyResourceAdditions = a.ResourceAdditions
+		e.ApplyResourceChanges = a.ResourceChanges
+		e.ApplyResourceDestructions = a.ResourceDestructions
+		if ts := a.StatusTimestamps; ts != nil {
+			e.ApplyStartedAt = ts.StartedAt
+			e.ApplyFinishedAt = firstTime(ts.FinishedAt, ts.ErroredAt, ts.CanceledAt, ts.ForceCanceledAt)
+		}
+	}
+
+	if ce := r.CostEstimate; ce != nil && ce.Status == CostEstimateFinished {
+		e.CostEstimateDelta, err = ce.Delta()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch r.Status {
+	case RunApplied, RunCanceled, RunDiscarded, RunErrored, RunPlannedAndFinished:
+		e.FinishedAt = e.PlanFinishedAt
+		if e.ApplyFinishedAt.After(e.FinishedAt) {
+			e.FinishedAt = e.ApplyFinishedAt
+		}
+		if ts := r.StatusTimestamps; ts != nil {
+			for _, t := range []time.Time{ts.AppliedAt, ts.ErroredAt, ts.FinishedAt, ts.PlannedAndFinishedAt} {
+				if t.After(e.FinishedAt) {
+					e.FinishedAt = t
+				}
+			}
+		}
+	}
+
+	return e, nil
+}
+
+// firstTime returns the first of the given times that is not zero.
+func firstTime(times ...time.Time) time.Time {
+	for _, t := range times {
+		if !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// Apply a run by its ID. ErrRunActionNotAllowed is returned when the run
+// can't be applied in its current status.
 func (s *runs) Apply(ctx context.Context, runID string, options RunApplyOptions) error {
 	if !validStringID(&runID) {
 		return errors.New("invalid value for run ID")
@@ -264,7 +574,8 @@ type RunCancelOptions struct {
 	Comment *string `json:"comment,omitempty"`
 }
 
-// Cancel a run by its ID.
+// Cancel a run by its ID. ErrRunActionNotAllowed is returned when the run
+// can't be canceled in its current status.
 func (s *runs) Cancel(ctx context.Context, runID string, options RunCancelOptions) error {
 	if !validStringID(&runID) {
 		return errors.New("invalid value for run ID")
@@ -306,7 +617,8 @@ type RunDiscardOptions struct {
 	Comment *string `json:"comment,omitempty"`
 }
 
-// Discard a run by its ID.
+// Discard a run by its ID. ErrRunActionNotAllowed is returned when the run
+// can't be discarded in its current status.
 func (s *runs) Discard(ctx context.Context, runID string, options RunDiscardOptions) error {
 	if !validStringID(&runID) {
 		return errors.New("invalid value for run ID")
@@ -0,0 +1,131 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ RunEvents = (*runEvents)(nil)
+
+// RunEvents describes all the run event related methods that the Terraform
+// Enterprise API supports.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/run.html#list-run-events
+type RunEvents interface {
+	// List all the run events of the given run.
+	List(ctx context.Context, runID string, options RunEventListOptions) (*RunEventList, error)
+
+	// Read a run event by its ID.
+	Read(ctx context.Context, runEventID string) (*RunEvent, error)
+}
+
+// runEvents implements RunEvents.
+type runEvents struct {
+	client *Client
+}
+
+// RunEventList represents a list of run events.
+type RunEventList struct {
+	*Pagination
+	Items []*RunEvent
+}
+
+// RunEvent represents a Terraform Enterprise run event.
+type RunEvent struct {
+	ID          string    `jsonapi:"primary,run-events"`
+	Action      string    `jsonapi:"attr,action"`
+	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
+	Description string    `jsonapi:"attr,description"`
+
+	// Relations
+	Actor *User `jsonapi:"relation,actor"`
+}
+
+// RunEventListOptions represents the options for listing run events.
+type RunEventListOptions struct {
+	ListOptions
+
+	// Only return events created after this timestamp. The API has no
+	// support for this filter, so it is applied to each retrieved page.
+	Since *time.Time `url:"-"`
+
+	// Only return events with one of the given actions. The API has no
+	// support for this filter, so it is applied to each retrieved page.
+	Actions []string `url:"-"`
+}
+
+// List all the run events of the given run. When Since or Actions are used,
+// the pagination details still describe the unfiltered list.
+func (s *runEvents) List(ctx context.Context, runID string, options RunEventListOptions) (*RunEventList, error) {
+	if !validStringID(&runID) {
+		return nil, errors.New("invalid value for run ID")
+	}
+
+	u := fmt.Sprintf("runs/%s/run-events", url.QueryEscape(runID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rel := &RunEventList{}
+	err = s.client.do(ctx, req, rel)
+	if err != nil {
+		return nil, err
+	}
+
+	rel.Items = filterRunEvents(rel.Items, options)
+
+	return rel, nil
+}
+
+// filterRunEvents returns the run events matching the Since and Actions
+// filters of the given options.
+func filterRunEvents(events []*RunEvent, options RunEventListOptions) []*RunEvent {
+	if options.Since == nil && len(options.Actions) == 0 {
+		return events
+	}
+
+	actions := make(map[string]bool, len(options.Actions))
+	for _, action := range options.Actions {
+		actions[action] = true
+	}
+
+	var filtered []*RunEvent
+	for _, e := range events {
+		if options.Since != nil && !e.CreatedAt.After(*options.Since) {
+			continue
+		}
+		if len(actions) > 0 && !actions[e.Action] {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+
+	return filtered
+}
+
+// Read a run event by its ID.
+func (s *runEvents) Read(ctx context.Context, runEventID string) (*RunEvent, error) {
+	if !validStringID(&runEventID) {
+		return nil, errors.New("invalid value for run event ID")
+	}
+
+	u := fmt.Sprintf("run-events/%s", url.QueryEscape(runEventID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	re := &RunEvent{}
+	err = s.client.do(ctx, req, re)
+	if err != nil {
+		return nil, err
+	}
+
+	return re, nil
+}
@@ -0,0 +1,104 @@
+package tfe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunEventsList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createRun(t, client, nil)
+	defer rTestCleanup()
+
+	t.Run("without list options", func(t *testing.T) {
+		rel, err := client.RunEvents.List(ctx, rTest.ID, RunEventListOptions{})
+		require.NoError(t, err)
+		assert.NotEmpty(t, rel.Items)
+	})
+
+	t.Run("with a since filter in the future", func(t *testing.T) {
+		rel, err := client.RunEvents.List(ctx, rTest.ID, RunEventListOptions{
+			Since: Time(time.Now().Add(time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, rel.Items)
+	})
+
+	t.Run("without a valid run ID", func(t *testing.T) {
+		rel, err := client.RunEvents.List(ctx, badIdentifier, RunEventListOptions{})
+		assert.Nil(t, rel)
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
+
+func TestRunEventsRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createRun(t, client, nil)
+	defer rTestCleanup()
+
+	rel, err := client.RunEvents.List(ctx, rTest.ID, RunEventListOptions{})
+	require.NoError(t, err)
+	require.NotEmpty(t, rel.Items)
+
+	t.Run("when the run event exists", func(t *testing.T) {
+		re, err := client.RunEvents.Read(ctx, rel.Items[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, rel.Items[0].ID, re.ID)
+		assert.Equal(t, rel.Items[0].Action, re.Action)
+	})
+
+	t.Run("when the run event does not exist", func(t *testing.T) {
+		re, err := client.RunEvents.Read(ctx, "re-nonexisting")
+		assert.Nil(t, re)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid run event ID", func(t *testing.T) {
+		re, err := client.RunEvents.Read(ctx, badIdentifier)
+		assert.Nil(t, re)
+		assert.EqualError(t, err, "invalid value for run event ID")
+	})
+}
+
+func TestRunEventsFilter(t *testing.T) {
+	now := time.Now()
+	events := []*RunEvent{
+		{ID: "re-1", Action: "queued", CreatedAt: now.Add(-2 * time.Minute)},
+		{ID: "re-2", Action: "planned", CreatedAt: now.Add(-1 * time.Minute)},
+		{ID: "re-3", Action: "applied", CreatedAt: now},
+	}
+
+	t.Run("without filters", func(t *testing.T) {
+		assert.Equal(t, events, filterRunEvents(events, RunEventListOptions{}))
+	})
+
+	t.Run("with a since filter", func(t *testing.T) {
+		filtered := filterRunEvents(events, RunEventListOptions{
+			Since: Time(now.Add(-90 * time.Second)),
+		})
+		assert.Equal(t, []*RunEvent{events[1], events[2]}, filtered)
+	})
+
+	t.Run("with an actions filter", func(t *testing.T) {
+		filtered := filterRunEvents(events, RunEventListOptions{
+			Actions: []string{"queued", "applied"},
+		})
+		assert.Equal(t, []*RunEvent{events[0], events[2]}, filtered)
+	})
+
+	t.Run("with both filters", func(t *testing.T) {
+		filtered := filterRunEvents(events, RunEventListOptions{
+			Since:   Time(now.Add(-90 * time.Second)),
+			Actions: []string{"queued", "applied"},
+		})
+		assert.Equal(t, []*RunEvent{events[2]}, filtered)
+	})
+}
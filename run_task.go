@@ -0,0 +1,348 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Compile-time proof of interface implementation.
+var _ RunTasks = (*runTasks)(nil)
+
+// RunTasks describes all the run task related methods that the Terraform
+// Enterprise API supports.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/run-tasks.html
+type RunTasks interface {
+	// List all the run tasks of the given organization.
+	List(ctx context.Context, organization string, options RunTaskListOptions) (*RunTaskList, error)
+
+	// Create a new run task with the given options.
+	Create(ctx context.Context, organization string, options RunTaskCreateOptions) (*RunTask, error)
+
+	// Read a run task by its ID.
+	Read(ctx context.Context, runTaskID string) (*RunTask, error)
+
+	// Update a run task by its ID.
+	Update(ctx context.Context, runTaskID string, options RunTaskUpdateOptions) (*RunTask, error)
+
+	// Delete a run task by its ID.
+	Delete(ctx context.Context, runTaskID string) error
+}
+
+// runTasks implements RunTasks.
+type runTasks struct {
+	client *Client
+}
+
+// Stage represents the stage of a run in which a run task is executed.
+type Stage string
+
+// List of available run task stages.
+const (
+	PrePlan   Stage = "pre_plan"
+	PostPlan  Stage = "post_plan"
+	PreApply  Stage = "pre_apply"
+	PostApply Stage = "post_apply"
+)
+
+// TaskEnforcementLevel represents the enforcement level of a run task.
+type TaskEnforcementLevel string
+
+// List of available run task enforcement levels.
+const (
+	Advisory  TaskEnforcementLevel = "advisory"
+	Mandatory TaskEnforcementLevel = "mandatory"
+)
+
+// RunTaskList represents a list of run tasks.
+type RunTaskList struct {
+	*Pagination
+	Items []*RunTask
+}
+
+// RunTask represents a Terraform Enterprise run task.
+type RunTask struct {
+	ID          string         `jsonapi:"primary,tasks"`
+	Category    string         `jsonapi:"attr,category"`
+	Description string         `jsonapi:"attr,description"`
+	Enabled     bool           `jsonapi:"attr,enabled"`
+	Global      *GlobalRunTask `jsonapi:"attr,global-configuration"`
+	HMACKey     *string        `jsonapi:"attr,hmac-key"`
+	Name        string         `jsonapi:"attr,name"`
+	URL         string         `jsonapi:"attr,url"`
+
+	// Relations
+	Organization *Organization `jsonapi:"relation,organization"`
+}
+
+// GlobalRunTask represents the global configuration of a run task. When
+// enabled, the run task is executed for every workspace of the organization
+// in the given stages and with the given enforcement level.
+type GlobalRunTask struct {
+	Enabled          bool                 `json:"enabled"`
+	EnforcementLevel TaskEnforcementLevel `json:"enforcement-level"`
+	Stages           []Stage              `json:"stages"`
+}
+
+// GlobalRunTaskOptions represents the options for the global configuration
+// of a run task.
+type GlobalRunTaskOptions struct {
+	// Whether to execute the run task for every workspace.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The enforcement level used for every workspace.
+	EnforcementLevel *TaskEnforcementLevel `json:"enforcement-level,omitempty"`
+
+	// The stages the run task is executed in for every workspace.
+	Stages []Stage `json:"stages,omitempty"`
+}
+
+func (o *GlobalRunTaskOptions) valid() error {
+	if o == nil {
+		return nil
+	}
+	if o.EnforcementLevel != nil && !validTaskEnforcementLevel(*o.EnforcementLevel) {
+		return validationError("invalid value for enforcement level")
+	}
+	for _, stage := range o.Stages {
+		if !validStage(stage) {
+			return validationError("invalid value for stage")
+		}
+	}
+	if o.Enabled != nil && *o.Enabled && len(o.Stages) == 0 {
+		return validationError("stages are required when the global configuration is enabled")
+	}
+	if o.EnforcementLevel != nil {
+		for _, stage := range o.Stages {
+			if err := validStageEnforcement(stage, *o.EnforcementLevel); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validStage(v Stage) bool {
+	switch v {
+	case PrePlan, PostPlan, PreApply, PostApply:
+		return true
+	}
+	return false
+}
+
+func validTaskEnforcementLevel(v TaskEnforcementLevel) bool {
+	switch v {
+	case Advisory, Mandatory:
+		return true
+	}
+	return false
+}
+
+// validStageEnforcement validates the combination of a stage and an
+// enforcement level. A run task in the post_apply stage is executed after
+// the changes are applied, so it can't prevent them and can't be mandatory.
+func validStageEnforcement(stage Stage, level TaskEnforcementLevel) error {
+	if stage == PostApply && level == Mandatory {
+		return validationError("enforcement level can not be mandatory in the post_apply stage")
+	}
+	return nil
+}
+
+// RunTaskListOptions represents the options for listing run tasks.
+type RunTaskListOptions struct {
+	ListOptions
+}
+
+// List all the run tasks of the given organization.
+func (s *runTasks) List(ctx context.Context, organization string, options RunTaskListOptions) (*RunTaskList, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s/tasks", url.QueryEscape(organization))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rtl := &RunTaskList{}
+	err = s.client.do(ctx, req, rtl)
+	if err != nil {
+		return nil, err
+	}
+
+	return rtl, nil
+}
+
+// RunTaskCreateOptions represents the options for creating a run task.
+type RunTaskCreateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,tasks"`
+
+	// The name of the run task.
+	Name *string `jsonapi:"attr,name"`
+
+	// The URL to send the run task payload to.
+	URL *string `jsonapi:"attr,url"`
+
+	// Must be "task".
+	Category *string `jsonapi:"attr,category"`
+
+	// The description of the run task.
+	Description *string `jsonapi:"attr,description,omitempty"`
+
+	// The HMAC key used to verify the run task payload.
+	HMACKey *string `jsonapi:"attr,hmac-key,omitempty"`
+
+	// Whether the run task is enabled.
+	Enabled *bool `jsonapi:"attr,enabled,omitempty"`
+
+	// The global configuration of the run task.
+	Global *GlobalRunTaskOptions `jsonapi:"attr,global-configuration,omitempty"`
+}
+
+// Validate checks the run task create options for errors, without making an
+// API request.
+func (o RunTaskCreateOptions) Validate() error {
+	if !validString(o.Name) {
+		return validationError("name is required")
+	}
+	if !validString(o.URL) {
+		return validationError("url is required")
+	}
+	if !validString(o.Category) {
+		return validationError("category is required")
+	}
+	if *o.Category != "task" {
+		return validationError(`category must be "task"`)
+	}
+	return o.Global.valid()
+}
+
+// Create a new run task with the given options.
+func (s *runTasks) Create(ctx context.Context, organization string, options RunTaskCreateOptions) (*RunTask, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("organizations/%s/tasks", url.QueryEscape(organization))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &RunTask{}
+	err = s.client.do(ctx, req, rt)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+// Read a run task by its ID.
+func (s *runTasks) Read(ctx context.Context, runTaskID string) (*RunTask, error) {
+	if !validStringID(&runTaskID) {
+		return nil, errors.New("invalid value for run task ID")
+	}
+
+	u := fmt.Sprintf("tasks/%s", url.QueryEscape(runTaskID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &RunTask{}
+	err = s.client.do(ctx, req, rt)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+// RunTaskUpdateOptions represents the options for updating a run task.
+type RunTaskUpdateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,tasks"`
+
+	// A new name for the run task.
+	Name *string `jsonapi:"attr,name,omitempty"`
+
+	// A new URL to send the run task payload to.
+	URL *string `jsonapi:"attr,url,omitempty"`
+
+	// A new description of the run task.
+	Description *string `jsonapi:"attr,description,omitempty"`
+
+	// A new HMAC key used to verify the run task payload.
+	HMACKey *string `jsonapi:"attr,hmac-key,omitempty"`
+
+	// Whether the run task is enabled.
+	Enabled *bool `jsonapi:"attr,enabled,omitempty"`
+
+	// The global configuration of the run task.
+	Global *GlobalRunTaskOptions `jsonapi:"attr,global-configuration,omitempty"`
+}
+
+// Validate checks the run task update options for errors, without making an
+// API request.
+func (o RunTaskUpdateOptions) Validate() error {
+	if o.Name != nil && !validString(o.Name) {
+		return validationError("invalid value for name")
+	}
+	return o.Global.valid()
+}
+
+// Update a run task by its ID.
+func (s *runTasks) Update(ctx context.Context, runTaskID string, options RunTaskUpdateOptions) (*RunTask, error) {
+	if !validStringID(&runTaskID) {
+		return nil, errors.New("invalid value for run task ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("tasks/%s", url.QueryEscape(runTaskID))
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &RunTask{}
+	err = s.client.do(ctx, req, rt)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+// Delete a run task by its ID.
+func (s *runTasks) Delete(ctx context.Context, runTaskID string) error {
+	if !validStringID(&runTaskID) {
+		return errors.New("invalid value for run task ID")
+	}
+
+	u := fmt.Sprintf("tasks/%s", url.QueryEscape(runTaskID))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
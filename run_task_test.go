@@ -0,0 +1,220 @@
+package tfe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTasksList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	rtTest1, _ := createRunTask(t, client, orgTest)
+	rtTest2, _ := createRunTask(t, client, orgTest)
+
+	t.Run("without list options", func(t *testing.T) {
+		rtl, err := client.RunTasks.List(ctx, orgTest.Name, RunTaskListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, rtl.Items, 2)
+
+		ids := []string{rtl.Items[0].ID, rtl.Items[1].ID}
+		assert.Contains(t, ids, rtTest1.ID)
+		assert.Contains(t, ids, rtTest2.ID)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		rtl, err := client.RunTasks.List(ctx, badIdentifier, RunTaskListOptions{})
+		assert.Nil(t, rtl)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
+func TestRunTasksCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	t.Run("with a global configuration", func(t *testing.T) {
+		options := RunTaskCreateOptions{
+			Name:     String(randomString(t)),
+			URL:      String("https://example.com/run-task"),
+			Category: String("task"),
+			Global: &GlobalRunTaskOptions{
+				Enabled:          Bool(true),
+				EnforcementLevel: TaskEnforcement(Mandatory),
+				Stages:           []Stage{PrePlan, PostPlan},
+			},
+		}
+
+		rt, err := client.RunTasks.Create(ctx, orgTest.Name, options)
+		require.NoError(t, err)
+
+		// Get a refreshed view from the API.
+		refreshed, err := client.RunTasks.Read(ctx, rt.ID)
+		require.NoError(t, err)
+
+		for _, item := range []*RunTask{
+			rt,
+			refreshed,
+		} {
+			assert.Equal(t, *options.Name, item.Name)
+			assert.Equal(t, *options.URL, item.URL)
+			require.NotNil(t, item.Global)
+			assert.True(t, item.Global.Enabled)
+			assert.Equal(t, Mandatory, item.Global.EnforcementLevel)
+			assert.Equal(t, []Stage{PrePlan, PostPlan}, item.Global.Stages)
+		}
+	})
+
+	t.Run("without a name", func(t *testing.T) {
+		rt, err := client.RunTasks.Create(ctx, orgTest.Name, RunTaskCreateOptions{
+			URL:      String("https://example.com/run-task"),
+			Category: String("task"),
+		})
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "name is required")
+	})
+
+	t.Run("without a url", func(t *testing.T) {
+		rt, err := client.RunTasks.Create(ctx, orgTest.Name, RunTaskCreateOptions{
+			Name:     String(randomString(t)),
+			Category: String("task"),
+		})
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "url is required")
+	})
+
+	t.Run("with an invalid category", func(t *testing.T) {
+		rt, err := client.RunTasks.Create(ctx, orgTest.Name, RunTaskCreateOptions{
+			Name:     String(randomString(t)),
+			URL:      String("https://example.com/run-task"),
+			Category: String("foo"),
+		})
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, `category must be "task"`)
+	})
+
+	t.Run("with an invalid global stage", func(t *testing.T) {
+		rt, err := client.RunTasks.Create(ctx, orgTest.Name, RunTaskCreateOptions{
+			Name:     String(randomString(t)),
+			URL:      String("https://example.com/run-task"),
+			Category: String("task"),
+			Global: &GlobalRunTaskOptions{
+				Enabled: Bool(true),
+				Stages:  []Stage{"foo"},
+			},
+		})
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "invalid value for stage")
+	})
+
+	t.Run("with an enabled global configuration without stages", func(t *testing.T) {
+		rt, err := client.RunTasks.Create(ctx, orgTest.Name, RunTaskCreateOptions{
+			Name:     String(randomString(t)),
+			URL:      String("https://example.com/run-task"),
+			Category: String("task"),
+			Global: &GlobalRunTaskOptions{
+				Enabled: Bool(true),
+			},
+		})
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "stages are required when the global configuration is enabled")
+	})
+}
+
+func TestRunTasksRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rtTest, rtTestCleanup := createRunTask(t, client, nil)
+	defer rtTestCleanup()
+
+	t.Run("when the run task exists", func(t *testing.T) {
+		rt, err := client.RunTasks.Read(ctx, rtTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rtTest.ID, rt.ID)
+		assert.Equal(t, rtTest.Name, rt.Name)
+	})
+
+	t.Run("when the run task does not exist", func(t *testing.T) {
+		rt, err := client.RunTasks.Read(ctx, "task-nonexisting")
+		assert.Nil(t, rt)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid run task ID", func(t *testing.T) {
+		rt, err := client.RunTasks.Read(ctx, badIdentifier)
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "invalid value for run task ID")
+	})
+}
+
+func TestRunTasksUpdate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rtTest, rtTestCleanup := createRunTask(t, client, nil)
+	defer rtTestCleanup()
+
+	t.Run("when enabling the global configuration", func(t *testing.T) {
+		rt, err := client.RunTasks.Update(ctx, rtTest.ID, RunTaskUpdateOptions{
+			Global: &GlobalRunTaskOptions{
+				Enabled:          Bool(true),
+				EnforcementLevel: TaskEnforcement(Advisory),
+				Stages:           []Stage{PostPlan},
+			},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, rt.Global)
+		assert.True(t, rt.Global.Enabled)
+		assert.Equal(t, Advisory, rt.Global.EnforcementLevel)
+	})
+
+	t.Run("with an invalid enforcement level", func(t *testing.T) {
+		rt, err := client.RunTasks.Update(ctx, rtTest.ID, RunTaskUpdateOptions{
+			Global: &GlobalRunTaskOptions{
+				EnforcementLevel: TaskEnforcement("foo"),
+			},
+		})
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "invalid value for enforcement level")
+	})
+
+	t.Run("without a valid run task ID", func(t *testing.T) {
+		rt, err := client.RunTasks.Update(ctx, badIdentifier, RunTaskUpdateOptions{})
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "invalid value for run task ID")
+	})
+}
+
+func TestRunTasksDelete(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	rtTest, _ := createRunTask(t, client, orgTest)
+
+	t.Run("with valid options", func(t *testing.T) {
+		err := client.RunTasks.Delete(ctx, rtTest.ID)
+		require.NoError(t, err)
+
+		// Try loading the run task - it should fail.
+		_, err = client.RunTasks.Read(ctx, rtTest.ID)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid run task ID", func(t *testing.T) {
+		err := client.RunTasks.Delete(ctx, badIdentifier)
+		assert.EqualError(t, err, "invalid value for run task ID")
+	})
+}
@@ -2,6 +2,10 @@ package tfe
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
 	"testing"
 	"time"
 
@@ -50,6 +54,40 @@ func TestRunsList(t *testing.T) {
 		assert.Equal(t, 2, rl.TotalCount)
 	})
 
+	t.Run("when filtering by the creating user", func(t *testing.T) {
+		user, err := client.Users.ReadCurrent(ctx)
+		require.NoError(t, err)
+
+		rl, err := client.Runs.List(ctx, wTest.ID, RunListOptions{
+			User:    String(user.Username),
+			Include: "created_by",
+		})
+		require.NoError(t, err)
+		require.Len(t, rl.Items, 2)
+
+		for _, r := range rl.Items {
+			require.NotNil(t, r.CreatedBy)
+			assert.Equal(t, user.ID, r.CreatedBy.ID)
+			assert.Equal(t, user.Username, r.CreatedBy.Username)
+		}
+	})
+
+	t.Run("when filtering by an unknown user", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, wTest.ID, RunListOptions{
+			User: String("nonexisting"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, rl.Items)
+	})
+
+	t.Run("with an empty user", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, wTest.ID, RunListOptions{
+			User: String(""),
+		})
+		assert.Nil(t, rl)
+		assert.EqualError(t, err, "invalid value for user")
+	})
+
 	t.Run("without a valid workspace ID", func(t *testing.T) {
 		rl, err := client.Runs.List(ctx, badIdentifier, RunListOptions{})
 		assert.Nil(t, rl)
@@ -106,6 +144,147 @@ func TestRunsCreate(t *testing.T) {
 	})
 }
 
+func TestRunsCreateFromLatestConfiguration(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, _ := createWorkspace(t, client, orgTest)
+
+	t.Run("without an uploaded configuration version", func(t *testing.T) {
+		// A pending configuration version is not used for runs.
+		_, cvCleanup := createConfigurationVersion(t, client, wTest)
+		defer cvCleanup()
+
+		r, err := client.Runs.CreateFromLatestConfiguration(ctx, wTest.ID, RunCreateOptions{})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrNoConfigurationVersion, err)
+	})
+
+	t.Run("with an uploaded configuration version", func(t *testing.T) {
+		cvTest, _ := createUploadedConfigurationVersion(t, client, wTest)
+
+		r, err := client.Runs.CreateFromLatestConfiguration(ctx, wTest.ID, RunCreateOptions{
+			Message: String("yo"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, cvTest.ID, r.ConfigurationVersion.ID)
+		assert.Equal(t, "yo", r.Message)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		r, err := client.Runs.CreateFromLatestConfiguration(ctx, badIdentifier, RunCreateOptions{})
+		assert.Nil(t, r)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestRunsCreateFromLatestConfigurationRequests(t *testing.T) {
+	var body string
+	var listed []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/tfe/v2/workspaces/ws-current":
+			w.Write([]byte(`{"data": {"id": "ws-current", "type": "workspaces", "relationships": {
+				"current-configuration-version": {"data": {"id": "cv-2", "type": "configuration-versions"}}}}}`))
+		case "GET /api/tfe/v2/workspaces/ws-123":
+			w.Write([]byte(`{"data": {"id": "ws-123", "type": "workspaces", "relationships": {
+				"current-configuration-version": {"data": {"id": "cv-3", "type": "configuration-versions"}}}}}`))
+		case "GET /api/tfe/v2/workspaces/ws-empty":
+			w.Write([]byte(`{"data": {"id": "ws-empty", "type": "workspaces"}}`))
+		case "GET /api/tfe/v2/configuration-versions/cv-2":
+			w.Write([]byte(`{"data": {"id": "cv-2", "type": "configuration-versions", "attributes": {"status": "uploaded"}}}`))
+		case "GET /api/tfe/v2/configuration-versions/cv-3":
+			w.Write([]byte(`{"data": {"id": "cv-3", "type": "configuration-versions", "attributes": {"status": "uploaded", "speculative": true}}}`))
+		case "GET /api/tfe/v2/workspaces/ws-123/configuration-versions":
+			listed = append(listed, r.URL.Query().Get("page[number]"))
+			w.Write([]byte(`{
+				"data": [
+					{"id": "cv-4", "type": "configuration-versions", "attributes": {"status": "pending"}},
+					{"id": "cv-3", "type": "configuration-versions", "attributes": {"status": "uploaded", "speculative": true}},
+					{"id": "cv-2", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-1", "type": "configuration-versions", "attributes": {"status": "uploaded"}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 4}}
+			}`))
+		case "GET /api/tfe/v2/workspaces/ws-empty/configuration-versions":
+			// Every page has a next page, but none of the configuration
+			// versions was uploaded.
+			page := r.URL.Query().Get("page[number]")
+			listed = append(listed, page)
+			next, _ := strconv.Atoi(page)
+			fmt.Fprintf(w, `{
+				"data": [{"id": "cv-5", "type": "configuration-versions", "attributes": {"status": "pending"}}],
+				"meta": {"pagination": {"current-page": %s, "next-page": %d, "total-pages": 1000}}
+			}`, page, next+1)
+		case "POST /api/tfe/v2/runs":
+			b, _ := ioutil.ReadAll(r.Body)
+			body = string(b)
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "run-123", "type": "runs",
+				"relationships": {"configuration-version": {"data": {"id": "cv-2", "type": "configuration-versions"}}}}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("with the current configuration version", func(t *testing.T) {
+		body, listed = "", nil
+
+		r, err := client.Runs.CreateFromLatestConfiguration(ctx, "ws-current", RunCreateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "run-123", r.ID)
+		assert.Contains(t, body, `"configuration-version":{"data":{"type":"configuration-versions","id":"cv-2"}}`)
+		assert.Empty(t, listed)
+	})
+
+	t.Run("with a speculative configuration version", func(t *testing.T) {
+		body, listed = "", nil
+
+		r, err := client.Runs.CreateFromLatestConfiguration(ctx, "ws-123", RunCreateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "run-123", r.ID)
+		assert.Contains(t, body, `"configuration-version":{"data":{"type":"configuration-versions","id":"cv-2"}}`)
+		assert.Equal(t, []string{"1"}, listed)
+	})
+
+	t.Run("without an uploaded configuration version", func(t *testing.T) {
+		body, listed = "", nil
+
+		r, err := client.Runs.CreateFromLatestConfiguration(ctx, "ws-empty", RunCreateOptions{})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrNoConfigurationVersion, err)
+		assert.Equal(t, []string{"1", "2", "3"}, listed)
+		assert.Empty(t, body)
+	})
+}
+
+func TestRunApplyReason(t *testing.T) {
+	user := &User{ID: "user-123"}
+
+	cases := []struct {
+		name string
+		run  *Run
+		want RunApplyReason
+	}{
+		{"awaiting confirmation", &Run{Source: RunSourceAPI}, RunApplyReasonNone},
+		{"confirmed by a user", &Run{Source: RunSourceUI, ConfirmedBy: user}, RunApplyReasonManual},
+		{"confirmed by a user with auto-apply", &Run{AutoApply: true, ConfirmedBy: user}, RunApplyReasonManual},
+		{"auto-applied by the workspace", &Run{AutoApply: true, Source: RunSourceConfigurationVersion}, RunApplyReasonWorkspaceAutoApply},
+		{"auto-applied by a run trigger", &Run{AutoApply: true, Source: RunSourceRunTrigger}, RunApplyReasonRunTriggerAutoApply},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, c.run.ApplyReason())
+		})
+	}
+}
+
 func TestRunsRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -132,6 +311,189 @@ func TestRunsRead(t *testing.T) {
 	})
 }
 
+func TestRunsReadWithOptions(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/runs/run-123", r.URL.Path)
+		assert.Equal(t, "configuration_version.ingress_attributes", r.URL.Query().Get("include"))
+		w.Write([]byte(`{
+			"data": {
+				"id": "run-123",
+				"type": "runs",
+				"relationships": {
+					"configuration-version": {"data": {"id": "cv-123", "type": "configuration-versions"}}
+				}
+			},
+			"included": [
+				{
+					"id": "cv-123",
+					"type": "configuration-versions",
+					"relationships": {
+						"ingress-attributes": {"data": {"id": "ia-123", "type": "ingress-attributes"}}
+					}
+				},
+				{
+					"id": "ia-123",
+					"type": "ingress-attributes",
+					"attributes": {
+						"branch": "feature",
+						"commit-sha": "abc123",
+						"commit-url": "https://github.com/acme/infra/commit/abc123",
+						"is-pull-request": true,
+						"pull-request-number": 42
+					}
+				}
+			]
+		}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with the ingress attributes included", func(t *testing.T) {
+		r, err := client.Runs.ReadWithOptions(ctx, "run-123", RunReadOptions{
+			Include: "configuration_version.ingress_attributes",
+		})
+		require.NoError(t, err)
+
+		ia := r.IngressAttributes()
+		require.NotNil(t, ia)
+		assert.Equal(t, "feature", ia.Branch)
+		assert.Equal(t, "abc123", ia.CommitSHA)
+		assert.Equal(t, "https://github.com/acme/infra/commit/abc123", ia.CommitURL)
+		assert.True(t, ia.IsPullRequest)
+		assert.Equal(t, 42, ia.PullRequestNumber)
+	})
+
+	t.Run("without a valid run ID", func(t *testing.T) {
+		r, err := client.Runs.ReadWithOptions(ctx, badIdentifier, RunReadOptions{})
+		assert.Nil(t, r)
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
+
+func TestRunsExecution(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "plan,apply,cost_estimate", r.URL.Query().Get("include"))
+		switch r.URL.Path {
+		case "/api/tfe/v2/runs/run-applied":
+			w.Write([]byte(`{
+				"data": {
+					"id": "run-applied",
+					"type": "runs",
+					"attributes": {
+						"created-at": "2026-01-01T10:00:00Z",
+						"has-changes": true,
+						"status": "applied",
+						"status-timestamps": {"applied-at": "2026-01-01T10:05:00Z"}
+					},
+					"relationships": {
+						"plan": {"data": {"id": "plan-1", "type": "plans"}},
+						"apply": {"data": {"id": "apply-1", "type": "applies"}},
+						"cost-estimate": {"data": {"id": "ce-1", "type": "cost-estimates"}}
+					}
+				},
+				"included": [
+					{
+						"id": "plan-1",
+						"type": "plans",
+						"attributes": {
+							"resource-additions": 2,
+							"resource-changes": 1,
+							"resource-destructions": 0,
+							"status": "finished",
+							"status-timestamps": {"started-at": "2026-01-01T10:01:00Z", "finished-at": "2026-01-01T10:02:00Z"}
+						}
+					},
+					{
+						"id": "apply-1",
+						"type": "applies",
+						"attributes": {
+							"resource-additions": 2,
+							"resource-changes": 1,
+							"resource-destructions": 0,
+							"status": "finished",
+							"status-timestamps": {"started-at": "2026-01-01T10:03:00Z", "finished-at": "2026-01-01T10:04:30Z"}
+						}
+					},
+					{
+						"id": "ce-1",
+						"type": "cost-estimates",
+						"attributes": {
+							"delta-monthly-cost": "142.5",
+							"prior-monthly-cost": "4453.125",
+							"proposed-monthly-cost": "4595.625",
+							"status": "finished"
+						}
+					}
+				]
+			}`))
+		case "/api/tfe/v2/runs/run-planning":
+			w.Write([]byte(`{
+				"data": {
+					"id": "run-planning",
+					"type": "runs",
+					"attributes": {"created-at": "2026-01-01T10:00:00Z", "status": "planning"},
+					"relationships": {
+						"plan": {"data": {"id": "plan-2", "type": "plans"}},
+						"apply": {"data": {"id": "apply-2", "type": "applies"}}
+					}
+				},
+				"included": [
+					{"id": "plan-2", "type": "plans", "attributes": {"status": "running", "status-timestamps": {"started-at": "2026-01-01T10:01:00Z"}}},
+					{"id": "apply-2", "type": "applies", "attributes": {"status": "pending"}}
+				]
+			}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("with an applied run", func(t *testing.T) {
+		e, err := client.Runs.Execution(ctx, "run-applied")
+		require.NoError(t, err)
+
+		assert.Equal(t, "run-applied", e.RunID)
+		assert.Equal(t, RunApplied, e.Status)
+		assert.True(t, e.HasChanges)
+		assert.Equal(t, PlanFinished, e.PlanStatus)
+		assert.Equal(t, 2, e.PlanResourceAdditions)
+		assert.Equal(t, 1, e.PlanResourceChanges)
+		assert.Equal(t, ApplyFinished, e.ApplyStatus)
+		assert.Equal(t, 2, e.ApplyResourceAdditions)
+		assert.Equal(t, time.Date(2026, 1, 1, 10, 3, 0, 0, time.UTC), e.ApplyStartedAt.UTC())
+		assert.Equal(t, time.Date(2026, 1, 1, 10, 5, 0, 0, time.UTC), e.FinishedAt.UTC())
+		assert.Equal(t, 5*time.Minute, e.Duration())
+		require.NotNil(t, e.CostEstimateDelta)
+		assert.Equal(t, "+$142.50/mo (+3.2%)", e.CostEstimateDelta.String())
+	})
+
+	t.Run("with a run that is still planning", func(t *testing.T) {
+		e, err := client.Runs.Execution(ctx, "run-planning")
+		require.NoError(t, err)
+
+		assert.Equal(t, RunPlanning, e.Status)
+		assert.Equal(t, PlanRunning, e.PlanStatus)
+		assert.True(t, e.PlanFinishedAt.IsZero())
+		assert.Equal(t, ApplyStatus(""), e.ApplyStatus)
+		assert.True(t, e.FinishedAt.IsZero())
+		assert.Equal(t, time.Duration(0), e.Duration())
+		assert.Nil(t, e.CostEstimateDelta)
+	})
+
+	t.Run("when the run does not exist", func(t *testing.T) {
+		e, err := client.Runs.Execution(ctx, "nonexisting")
+		assert.Nil(t, e)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with an invalid run ID", func(t *testing.T) {
+		e, err := client.Runs.Execution(ctx, badIdentifier)
+		assert.Nil(t, e)
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
+
 func TestRunsApply(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -0,0 +1,254 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ RunTriggers = (*runTriggers)(nil)
+
+// RunTriggers describes all the run trigger related methods that the
+// Terraform Enterprise API supports. A run trigger queues a run in a
+// workspace whenever a run in its source workspace was applied.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/run-triggers.html
+type RunTriggers interface {
+	// List all the inbound or outbound run triggers of the given workspace.
+	List(ctx context.Context, workspaceID string, options RunTriggerListOptions) (*RunTriggerList, error)
+
+	// Create a new run trigger with the given workspace as its target. It
+	// returns ErrRunTriggerCycle when the run trigger would create a cycle.
+	Create(ctx context.Context, workspaceID string, options RunTriggerCreateOptions) (*RunTrigger, error)
+
+	// Read a run trigger by its ID.
+	Read(ctx context.Context, runTriggerID string) (*RunTrigger, error)
+
+	// Delete a run trigger by its ID.
+	Delete(ctx context.Context, runTriggerID string) error
+}
+
+// runTriggers implements RunTriggers.
+type runTriggers struct {
+	client *Client
+}
+
+// RunTriggerFilterType represents the direction of the run triggers to list.
+type RunTriggerFilterType string
+
+// List of available run trigger filter types.
+const (
+	// RunTriggerInbound lists the run triggers with the workspace as their
+	// target, i.e. the workspaces that queue runs in the workspace.
+	RunTriggerInbound RunTriggerFilterType = "inbound"
+	// RunTriggerOutbound lists the run triggers with the workspace as their
+	// source, i.e. the workspaces the workspace queues runs in.
+	RunTriggerOutbound RunTriggerFilterType = "outbound"
+)
+
+// RunTriggerList represents a list of run triggers.
+type RunTriggerList struct {
+	*Pagination
+	Items []*RunTrigger
+}
+
+// RunTrigger represents a Terraform Enterprise run trigger.
+type RunTrigger struct {
+	ID             string    `jsonapi:"primary,run-triggers"`
+	CreatedAt      time.Time `jsonapi:"attr,created-at,iso8601"`
+	SourceableName string    `jsonapi:"attr,sourceable-name"`
+	WorkspaceName  string    `jsonapi:"attr,workspace-name"`
+
+	// Relations
+	Sourceable *Workspace `jsonapi:"relation,sourceable"`
+	Workspace  *Workspace `jsonapi:"relation,workspace"`
+}
+
+// RunTriggerListOptions represents the options for listing run triggers.
+type RunTriggerListOptions struct {
+	ListOptions
+
+	// The direction of the run triggers to list, required.
+	RunTriggerType *RunTriggerFilterType `url:"filter[run-trigger][type]"`
+}
+
+// Validate checks the run trigger list options for errors, without making an
+// API request.
+func (o RunTriggerListOptions) Validate() error {
+	if o.RunTriggerType == nil {
+		return validationError("run trigger type is required")
+	}
+	switch *o.RunTriggerType {
+	case RunTriggerInbound, RunTriggerOutbound:
+		return nil
+	}
+	return validationError("invalid value for run trigger type")
+}
+
+// List all the inbound or outbound run triggers of the given workspace.
+func (s *runTriggers) List(ctx context.Context, workspaceID string, options RunTriggerListOptions) (*RunTriggerList, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("workspaces/%s/run-triggers", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rtl := &RunTriggerList{}
+	err = s.client.do(ctx, req, rtl)
+	if err != nil {
+		return nil, err
+	}
+
+	return rtl, nil
+}
+
+// RunTriggerCreateOptions represents the options for creating a run trigger.
+type RunTriggerCreateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,run-triggers"`
+
+	// The source workspace, of which the applied runs queue a run in the
+	// target workspace.
+	Sourceable *Workspace `jsonapi:"relation,sourceable"`
+
+	// Check the existing run triggers for a cycle before creating the run
+	// trigger. This lists the inbound run triggers of the source workspace
+	// and of every workspace upstream of it, so it costs a request per
+	// workspace. Not sent to the API.
+	CheckCycles bool
+}
+
+// Validate checks the run trigger create options for errors, without making
+// an API request.
+func (o RunTriggerCreateOptions) Validate() error {
+	if o.Sourceable == nil {
+		return validationError("sourceable is required")
+	}
+	if !validStringID(&o.Sourceable.ID) {
+		return validationError("invalid value for sourceable ID")
+	}
+	return nil
+}
+
+// Create a new run trigger with the given workspace as its target. It returns
+// ErrRunTriggerCycle when the run trigger would create a cycle.
+func (s *runTriggers) Create(ctx context.Context, workspaceID string, options RunTriggerCreateOptions) (*RunTrigger, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	if options.Sourceable.ID == workspaceID {
+		return nil, ErrRunTriggerCycle
+	}
+	if options.CheckCycles {
+		if err := s.checkCycles(ctx, workspaceID, options.Sourceable.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("workspaces/%s/run-triggers", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &RunTrigger{}
+	err = s.client.do(ctx, req, rt)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+// checkCycles returns ErrRunTriggerCycle when the workspace already queues
+// runs in the source workspace, either directly or through other workspaces.
+// It walks the inbound run triggers upstream from the source workspace.
+func (s *runTriggers) checkCycles(ctx context.Context, workspaceID, sourceableID string) error {
+	seen := map[string]bool{sourceableID: true}
+	queue := []string{sourceableID}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		err := listPages(0, func(pageNumber int) (*Pagination, bool, error) {
+			rtl, err := s.List(ctx, id, RunTriggerListOptions{
+				ListOptions:    ListOptions{PageNumber: pageNumber},
+				RunTriggerType: RunTriggerFilter(RunTriggerInbound),
+			})
+			if err != nil {
+				return nil, false, err
+			}
+
+			for _, rt := range rtl.Items {
+				if rt.Sourceable == nil || seen[rt.Sourceable.ID] {
+					continue
+				}
+				if rt.Sourceable.ID == workspaceID {
+					return nil, false, ErrRunTriggerCycle
+				}
+				seen[rt.Sourceable.ID] = true
+				queue = append(queue, rt.Sourceable.ID)
+			}
+			return rtl.Pagination, false, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Read a run trigger by its ID.
+func (s *runTriggers) Read(ctx context.Context, runTriggerID string) (*RunTrigger, error) {
+	if !validStringID(&runTriggerID) {
+		return nil, errors.New("invalid value for run trigger ID")
+	}
+
+	u := fmt.Sprintf("run-triggers/%s", url.QueryEscape(runTriggerID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &RunTrigger{}
+	err = s.client.do(ctx, req, rt)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+// Delete a run trigger by its ID.
+func (s *runTriggers) Delete(ctx context.Context, runTriggerID string) error {
+	if !validStringID(&runTriggerID) {
+		return errors.New("invalid value for run trigger ID")
+	}
+
+	u := fmt.Sprintf("run-triggers/%s", url.QueryEscape(runTriggerID))
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
@@ -0,0 +1,284 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTriggersList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	rtTest, rtTestCleanup := createRunTrigger(t, client, wTest, nil)
+	defer rtTestCleanup()
+
+	t.Run("with inbound run triggers", func(t *testing.T) {
+		rtl, err := client.RunTriggers.List(ctx, wTest.ID, RunTriggerListOptions{
+			RunTriggerType: RunTriggerFilter(RunTriggerInbound),
+		})
+		require.NoError(t, err)
+		require.Len(t, rtl.Items, 1)
+		assert.Equal(t, rtTest.ID, rtl.Items[0].ID)
+	})
+
+	t.Run("with outbound run triggers", func(t *testing.T) {
+		rtl, err := client.RunTriggers.List(ctx, wTest.ID, RunTriggerListOptions{
+			RunTriggerType: RunTriggerFilter(RunTriggerOutbound),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, rtl.Items)
+	})
+
+	t.Run("without a run trigger type", func(t *testing.T) {
+		rtl, err := client.RunTriggers.List(ctx, wTest.ID, RunTriggerListOptions{})
+		assert.Nil(t, rtl)
+		assert.EqualError(t, err, "run trigger type is required")
+	})
+
+	t.Run("with an invalid run trigger type", func(t *testing.T) {
+		rtl, err := client.RunTriggers.List(ctx, wTest.ID, RunTriggerListOptions{
+			RunTriggerType: RunTriggerFilter("sideways"),
+		})
+		assert.Nil(t, rtl)
+		assert.EqualError(t, err, "invalid value for run trigger type")
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		rtl, err := client.RunTriggers.List(ctx, badIdentifier, RunTriggerListOptions{
+			RunTriggerType: RunTriggerFilter(RunTriggerInbound),
+		})
+		assert.Nil(t, rtl)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestRunTriggersCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, _ := createWorkspace(t, client, orgTest)
+	sourceTest, _ := createWorkspace(t, client, orgTest)
+
+	t.Run("with a valid sourceable", func(t *testing.T) {
+		rt, err := client.RunTriggers.Create(ctx, wTest.ID, RunTriggerCreateOptions{
+			Sourceable: sourceTest,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, sourceTest.Name, rt.SourceableName)
+		assert.Equal(t, wTest.Name, rt.WorkspaceName)
+	})
+
+	t.Run("without a sourceable", func(t *testing.T) {
+		rt, err := client.RunTriggers.Create(ctx, wTest.ID, RunTriggerCreateOptions{})
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "sourceable is required")
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		rt, err := client.RunTriggers.Create(ctx, badIdentifier, RunTriggerCreateOptions{
+			Sourceable: sourceTest,
+		})
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestRunTriggersRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rtTest, rtTestCleanup := createRunTrigger(t, client, nil, nil)
+	defer rtTestCleanup()
+
+	t.Run("when the run trigger exists", func(t *testing.T) {
+		rt, err := client.RunTriggers.Read(ctx, rtTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rtTest.ID, rt.ID)
+		require.NotNil(t, rt.Sourceable)
+		require.NotNil(t, rt.Workspace)
+	})
+
+	t.Run("when the run trigger does not exist", func(t *testing.T) {
+		rt, err := client.RunTriggers.Read(ctx, "nonexisting")
+		assert.Nil(t, rt)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid run trigger ID", func(t *testing.T) {
+		rt, err := client.RunTriggers.Read(ctx, badIdentifier)
+		assert.Nil(t, rt)
+		assert.EqualError(t, err, "invalid value for run trigger ID")
+	})
+}
+
+func TestRunTriggersDelete(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rtTest, _ := createRunTrigger(t, client, nil, nil)
+
+	t.Run("with a valid run trigger ID", func(t *testing.T) {
+		err := client.RunTriggers.Delete(ctx, rtTest.ID)
+		require.NoError(t, err)
+
+		_, err = client.RunTriggers.Read(ctx, rtTest.ID)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid run trigger ID", func(t *testing.T) {
+		err := client.RunTriggers.Delete(ctx, badIdentifier)
+		assert.EqualError(t, err, "invalid value for run trigger ID")
+	})
+}
+
+func TestRunTriggersRequests(t *testing.T) {
+	var query, body string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/workspaces/ws-app/run-triggers", r.URL.Path)
+		switch r.Method {
+		case "GET":
+			query = r.URL.RawQuery
+			w.Write([]byte(`{"data": [{"id": "rt-123", "type": "run-triggers", "attributes": {
+				"sourceable-name": "networking", "workspace-name": "app"},
+				"relationships": {
+					"sourceable": {"data": {"id": "ws-net", "type": "workspaces"}},
+					"workspace": {"data": {"id": "ws-app", "type": "workspaces"}}}}],
+				"meta": {"pagination": {"current-page": 1, "total-count": 1}}}`))
+		case "POST":
+			b, _ := ioutil.ReadAll(r.Body)
+			body = string(b)
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "rt-123", "type": "run-triggers", "attributes": {
+				"sourceable-name": "networking", "workspace-name": "app"}}}`))
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when listing the inbound run triggers", func(t *testing.T) {
+		rtl, err := client.RunTriggers.List(ctx, "ws-app", RunTriggerListOptions{
+			RunTriggerType: RunTriggerFilter(RunTriggerInbound),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "filter%5Brun-trigger%5D%5Btype%5D=inbound", query)
+		require.Len(t, rtl.Items, 1)
+
+		rt := rtl.Items[0]
+		assert.Equal(t, "networking", rt.SourceableName)
+		require.NotNil(t, rt.Sourceable)
+		assert.Equal(t, "ws-net", rt.Sourceable.ID)
+		require.NotNil(t, rt.Workspace)
+		assert.Equal(t, "ws-app", rt.Workspace.ID)
+	})
+
+	t.Run("when creating a run trigger", func(t *testing.T) {
+		rt, err := client.RunTriggers.Create(ctx, "ws-app", RunTriggerCreateOptions{
+			Sourceable: &Workspace{ID: "ws-net"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "rt-123", rt.ID)
+		assert.Contains(t, body, `"sourceable":{"data":{"type":"workspaces","id":"ws-net"}}`)
+	})
+}
+
+func TestRunTriggersCreateCycles(t *testing.T) {
+	// The inbound run triggers of each workspace: ws-c queues runs in ws-b,
+	// which queues runs in ws-a.
+	sources := map[string][]string{
+		"ws-a": {"ws-b"},
+		"ws-b": {"ws-c"},
+	}
+
+	var requests []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/tfe/v2/workspaces/")
+		id = strings.TrimSuffix(id, "/run-triggers")
+
+		switch r.Method {
+		case "GET":
+			var items []string
+			for _, source := range sources[id] {
+				items = append(items, fmt.Sprintf(`{"id": "rt-%s-%s", "type": "run-triggers",
+					"relationships": {
+						"sourceable": {"data": {"id": %q, "type": "workspaces"}},
+						"workspace": {"data": {"id": %q, "type": "workspaces"}}}}`, source, id, source, id))
+			}
+			fmt.Fprintf(w, `{"data": [%s], "meta": {"pagination": {"current-page": 1, "total-count": %d}}}`,
+				strings.Join(items, ","), len(items))
+		case "POST":
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "rt-new", "type": "run-triggers"}}`))
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the workspace triggers itself", func(t *testing.T) {
+		requests = nil
+
+		rt, err := client.RunTriggers.Create(ctx, "ws-a", RunTriggerCreateOptions{
+			Sourceable: &Workspace{ID: "ws-a"},
+		})
+		assert.Nil(t, rt)
+		assert.Equal(t, ErrRunTriggerCycle, err)
+		assert.Empty(t, requests)
+	})
+
+	t.Run("when the run trigger would create a cycle", func(t *testing.T) {
+		requests = nil
+
+		rt, err := client.RunTriggers.Create(ctx, "ws-c", RunTriggerCreateOptions{
+			Sourceable:  &Workspace{ID: "ws-a"},
+			CheckCycles: true,
+		})
+		assert.Nil(t, rt)
+		assert.Equal(t, ErrRunTriggerCycle, err)
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/workspaces/ws-a/run-triggers",
+			"GET /api/tfe/v2/workspaces/ws-b/run-triggers",
+		}, requests)
+	})
+
+	t.Run("when the run trigger would not create a cycle", func(t *testing.T) {
+		requests = nil
+
+		rt, err := client.RunTriggers.Create(ctx, "ws-d", RunTriggerCreateOptions{
+			Sourceable:  &Workspace{ID: "ws-a"},
+			CheckCycles: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "rt-new", rt.ID)
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/workspaces/ws-a/run-triggers",
+			"GET /api/tfe/v2/workspaces/ws-b/run-triggers",
+			"GET /api/tfe/v2/workspaces/ws-c/run-triggers",
+			"POST /api/tfe/v2/workspaces/ws-d/run-triggers",
+		}, requests)
+	})
+
+	t.Run("when not checking for cycles", func(t *testing.T) {
+		requests = nil
+
+		rt, err := client.RunTriggers.Create(ctx, "ws-c", RunTriggerCreateOptions{
+			Sourceable: &Workspace{ID: "ws-a"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "rt-new", rt.ID)
+		assert.Equal(t, []string{"POST /api/tfe/v2/workspaces/ws-c/run-triggers"}, requests)
+	})
+}
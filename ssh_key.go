@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // Compile-time proof of interface implementation.
@@ -43,7 +44,8 @@ type SSHKeyList struct {
 	Items []*SSHKey
 }
 
-// SSHKey represents a SSH key.
+// SSHKey represents a SSH key. The private key itself is write-only, so it is
+// never returned when reading, creating or updating an SSH key.
 type SSHKey struct {
 	ID   string `jsonapi:"primary,ssh-keys"`
 	Name string `jsonapi:"attr,name"`
@@ -56,8 +58,9 @@ type SSHKeyListOptions struct {
 
 // List all the SSH keys for a given organization
 func (s *sshKeys) List(ctx context.Context, organization string, options SSHKeyListOptions) (*SSHKeyList, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/ssh-keys", url.QueryEscape(organization))
@@ -83,27 +86,30 @@ type SSHKeyCreateOptions struct {
 	// A name to identify the SSH key.
 	Name *string `jsonapi:"attr,name"`
 
-	// The content of the SSH private key.
+	// The content of the SSH private key, usually a PEM encoded key.
 	Value *string `jsonapi:"attr,value"`
 }
 
-func (o SSHKeyCreateOptions) valid() error {
+// Validate checks the SSH key create options for errors, without making an API
+// request.
+func (o SSHKeyCreateOptions) Validate() error {
 	if !validString(o.Name) {
-		return errors.New("name is required")
+		return validationError("name is required")
 	}
-	if !validString(o.Value) {
-		return errors.New("value is required")
+	if !validString(o.Value) || strings.TrimSpace(*o.Value) == "" {
+		return validationError("value is required")
 	}
 	return nil
 }
 
 // Create an SSH key and associate it with an organization.
 func (s *sshKeys) Create(ctx context.Context, organization string, options SSHKeyCreateOptions) (*SSHKey, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
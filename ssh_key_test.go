@@ -100,6 +100,15 @@ func TestSSHKeysCreate(t *testing.T) {
 		assert.EqualError(t, err, "value is required")
 	})
 
+	t.Run("when options has a blank value", func(t *testing.T) {
+		k, err := client.SSHKeys.Create(ctx, "foo", SSHKeyCreateOptions{
+			Name:  String(randomString(t)),
+			Value: String(" \n"),
+		})
+		assert.Nil(t, k)
+		assert.EqualError(t, err, "value is required")
+	})
+
 	t.Run("when options has an invalid organization", func(t *testing.T) {
 		k, err := client.SSHKeys.Create(ctx, badIdentifier, SSHKeyCreateOptions{
 			Name: String("foo"),
@@ -28,10 +28,25 @@ type StateVersions interface {
 	Read(ctx context.Context, svID string) (*StateVersion, error)
 
 	// Current reads the latest available state from the given workspace.
+	// It returns ErrNoStateVersion if the workspace has no state yet.
 	Current(ctx context.Context, workspaceID string) (*StateVersion, error)
 
-	// Download retrieves the actual stored state of a state version
+	// Download retrieves the actual stored state of a state version. The
+	// download URL is pre-signed and expires, and it is not refreshed, so
+	// use DownloadByID to refresh an expired URL.
 	Download(ctx context.Context, url string) ([]byte, error)
+
+	// DownloadByID retrieves the actual stored state of the state version
+	// with the given ID, refreshing the download URL when it expired.
+	DownloadByID(ctx context.Context, svID string) ([]byte, error)
+
+	// ListOutputs lists all the outputs of a state version.
+	ListOutputs(ctx context.Context, svID string, options StateVersionOutputListOptions) (*StateVersionOutputList, error)
+
+	// WaitForRun waits until the state version created by applying the
+	// given run is finalized. It returns ErrRunAwaitingAction when the run
+	// waits to be confirmed or for a policy override.
+	WaitForRun(ctx context.Context, runID string) (*StateVersion, error)
 }
 
 // stateVersions implements StateVersions.
@@ -39,6 +54,16 @@ type stateVersions struct {
 	client *Client
 }
 
+// StateVersionStatus represents a state version status.
+type StateVersionStatus string
+
+// List all available state version statuses.
+const (
+	StateVersionDiscarded StateVersionStatus = "discarded"
+	StateVersionFinalized StateVersionStatus = "finalized"
+	StateVersionPending   StateVersionStatus = "pending"
+)
+
 // StateVersionList represents a list of state versions.
 type StateVersionList struct {
 	*Pagination
@@ -47,15 +72,17 @@ type StateVersionList struct {
 
 // StateVersion represents a Terraform Enterprise state version.
 type StateVersion struct {
-	ID           string    `jsonapi:"primary,state-versions"`
-	CreatedAt    time.Time `jsonapi:"attr,created-at,iso8601"`
-	DownloadURL  string    `jsonapi:"attr,hosted-state-download-url"`
-	Serial       int64     `jsonapi:"attr,serial"`
-	VCSCommitSHA string    `jsonapi:"attr,vcs-commit-sha"`
-	VCSCommitURL string    `jsonapi:"attr,vcs-commit-url"`
+	ID           string             `jsonapi:"primary,state-versions"`
+	CreatedAt    time.Time          `jsonapi:"attr,created-at,iso8601"`
+	DownloadURL  string             `jsonapi:"attr,hosted-state-download-url"`
+	Serial       int64              `jsonapi:"attr,serial"`
+	Status       StateVersionStatus `jsonapi:"attr,status"`
+	VCSCommitSHA string             `jsonapi:"attr,vcs-commit-sha"`
+	VCSCommitURL string             `jsonapi:"attr,vcs-commit-url"`
 
 	// Relations
-	Run *Run `jsonapi:"relation,run"`
+	Outputs []*StateVersionOutput `jsonapi:"relation,outputs"`
+	Run     *Run                  `jsonapi:"relation,run"`
 }
 
 // StateVersionListOptions represents the options for listing state versions.
@@ -65,19 +92,21 @@ type StateVersionListOptions struct {
 	Workspace    *string `url:"filter[workspace][name]"`
 }
 
-func (o StateVersionListOptions) valid() error {
+// Validate checks the state version list options for errors, without making an
+// API request.
+func (o StateVersionListOptions) Validate() error {
 	if !validString(o.Organization) {
-		return errors.New("organization is required")
+		return validationError("organization is required")
 	}
 	if !validString(o.Workspace) {
-		return errors.New("workspace is required")
+		return validationError("workspace is required")
 	}
 	return nil
 }
 
 // List all the state versions for a given workspace.
 func (s *stateVersions) List(ctx context.Context, options StateVersionListOptions) (*StateVersionList, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -120,15 +149,17 @@ type StateVersionCreateOptions struct {
 	Run *Run `jsonapi:"relation,run,omitempty"`
 }
 
-func (o StateVersionCreateOptions) valid() error {
+// Validate checks the state version create options for errors, without making
+// an API request.
+func (o StateVersionCreateOptions) Validate() error {
 	if !validString(o.MD5) {
-		return errors.New("MD5 is required")
+		return validationError("MD5 is required")
 	}
 	if o.Serial == nil {
-		return errors.New("serial is required")
+		return validationError("serial is required")
 	}
 	if !validString(o.State) {
-		return errors.New("state is required")
+		return validationError("state is required")
 	}
 	return nil
 }
@@ -138,7 +169,7 @@ func (s *stateVersions) Create(ctx context.Context, workspaceID string, options
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -181,7 +212,9 @@ func (s *stateVersions) Read(ctx context.Context, svID string) (*StateVersion, e
 	return sv, nil
 }
 
-// Current reads the latest available state from the given workspace.
+// Current reads the latest available state from the given workspace. The API
+// responds with a 404 both when the workspace doesn't exist and when it has no
+// state yet, so the workspace is read to tell both cases apart.
 func (s *stateVersions) Current(ctx context.Context, workspaceID string) (*StateVersion, error) {
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
@@ -195,6 +228,11 @@ func (s *stateVersions) Current(ctx context.Context, workspaceID string) (*State
 
 	sv := &StateVersion{}
 	err = s.client.do(ctx, req, sv)
+	if IsNotFound(err) {
+		if _, werr := s.client.Workspaces.ReadByID(ctx, workspaceID); werr == nil {
+			return nil, ErrNoStateVersion
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +240,36 @@ func (s *stateVersions) Current(ctx context.Context, workspaceID string) (*State
 	return sv, nil
 }
 
-// Download retrieves the actual stored state of a state version
+// StateVersionOutputListOptions represents the options for listing the
+// outputs of a state version.
+type StateVersionOutputListOptions struct {
+	ListOptions
+}
+
+// ListOutputs lists all the outputs of a state version. The values of
+// sensitive outputs are not returned.
+func (s *stateVersions) ListOutputs(ctx context.Context, svID string, options StateVersionOutputListOptions) (*StateVersionOutputList, error) {
+	if !validStringID(&svID) {
+		return nil, errors.New("invalid value for state version ID")
+	}
+
+	u := fmt.Sprintf("state-versions/%s/outputs", url.QueryEscape(svID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	svol := &StateVersionOutputList{}
+	err = s.client.do(ctx, req, svol)
+	if err != nil {
+		return nil, err
+	}
+
+	return svol, nil
+}
+
+// Download retrieves the actual stored state of a state version. An expired
+// download URL is not refreshed, as only the URL is known here.
 func (s *stateVersions) Download(ctx context.Context, url string) ([]byte, error) {
 	req, err := s.client.newRequest("GET", url, nil)
 	if err != nil {
@@ -218,3 +285,124 @@ func (s *stateVersions) Download(ctx context.Context, url string) ([]byte, error
 
 	return buf.Bytes(), nil
 }
+
+// DownloadByID retrieves the actual stored state of a state version. The
+// state version is read to get its download URL, and read again for a fresh
+// URL when the download is refused because the URL expired.
+func (s *stateVersions) DownloadByID(ctx context.Context, svID string) ([]byte, error) {
+	if !validStringID(&svID) {
+		return nil, errors.New("invalid value for state version ID")
+	}
+
+	return s.client.download(ctx, "application/json", func() (string, error) {
+		sv, err := s.Read(ctx, svID)
+		if err != nil {
+			return "", err
+		}
+		return sv.DownloadURL, nil
+	})
+}
+
+// WaitForRun waits until the state version created by applying the given run
+// is finalized. The state of a run is processed asynchronously after it is
+// applied, so reading the current state version of the workspace right after
+// an apply can return the previous state version. State versions without a
+// status, as returned by older versions of the API, are considered finalized.
+//
+// The state version of a run that errored while applying is returned as well,
+// as the apply can have changed resources before it failed. An error is
+// returned when the run finished without a state version, and
+// ErrRunAwaitingAction when the run waits to be confirmed or for a policy
+// override. Use a context with a deadline to bound the time spent waiting on
+// runs that are queued.
+func (s *stateVersions) WaitForRun(ctx context.Context, runID string) (*StateVersion, error) {
+	if !validStringID(&runID) {
+		return nil, errors.New("invalid value for run ID")
+	}
+
+	// Loop until the context is canceled or the state version is finalized.
+	for {
+		sv, err := s.runStateVersion(ctx, runID)
+		if err != nil {
+			return nil, err
+		}
+
+		if sv != nil {
+			switch sv.Status {
+			case "", StateVersionFinalized:
+				return sv, nil
+			case StateVersionDiscarded:
+				return nil, fmt.Errorf("state version %s of run %s was discarded", sv.ID, runID)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// runStateVersion returns the latest state version created by applying the
+// given run, or nil when the run is not applied or its state version is not
+// created yet. An error is returned when the run finished without a state
+// version or waits for an action.
+func (s *stateVersions) runStateVersion(ctx context.Context, runID string) (*StateVersion, error) {
+	r, err := s.client.Runs.Read(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	notApplied := fmt.Errorf("run %s finished without being applied", runID)
+
+	switch r.Status {
+	case RunApplied, RunErrored:
+		// An apply that errored can still have created a state version.
+	case RunCanceled, RunDiscarded, RunPlannedAndFinished, RunPolicySoftFailed:
+		return nil, notApplied
+	case RunPolicyOverride:
+		return nil, ErrRunAwaitingAction
+	default:
+		if r.Actions != nil && r.Actions.IsConfirmable {
+			return nil, ErrRunAwaitingAction
+		}
+		return nil, nil
+	}
+
+	var svs []*StateVersion
+	if r.Apply != nil {
+		a, err := s.client.Applies.Read(ctx, r.Apply.ID)
+		if err != nil {
+			return nil, err
+		}
+		svs = a.StateVersions
+	}
+
+	if len(svs) == 0 {
+		if r.Status == RunErrored {
+			return nil, notApplied
+		}
+		return nil, nil
+	}
+
+	return s.latest(ctx, svs)
+}
+
+// latest reads the given state versions and returns the one with the highest
+// serial, or the most recently created one when the serials are equal.
+func (s *stateVersions) latest(ctx context.Context, svs []*StateVersion) (*StateVersion, error) {
+	var latest *StateVersion
+	for _, sv := range svs {
+		sv, err := s.Read(ctx, sv.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if latest == nil || sv.Serial > latest.Serial ||
+			(sv.Serial == latest.Serial && sv.CreatedAt.After(latest.CreatedAt)) {
+			latest = sv
+		}
+	}
+	return latest, nil
+}
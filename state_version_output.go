@@ -0,0 +1,80 @@
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Compile-time proof of interface implementation.
+var _ StateVersionOutputs = (*stateVersionOutputs)(nil)
+
+// StateVersionOutputs describes all the state version output related methods
+// that the Terraform Enterprise API supports.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/state-version-outputs.html
+type StateVersionOutputs interface {
+	// Read a state version output by its ID.
+	Read(ctx context.Context, outputID string) (*StateVersionOutput, error)
+}
+
+// stateVersionOutputs implements StateVersionOutputs.
+type stateVersionOutputs struct {
+	client *Client
+}
+
+// StateVersionOutputList represents a list of state version outputs.
+type StateVersionOutputList struct {
+	*Pagination
+	Items []*StateVersionOutput
+}
+
+// StateVersionOutput represents an output of a Terraform Enterprise state
+// version. The value of a sensitive output is nil.
+type StateVersionOutput struct {
+	ID        string `jsonapi:"primary,state-version-outputs"`
+	Name      string `jsonapi:"attr,name"`
+	Sensitive bool   `jsonapi:"attr,sensitive"`
+	Type      string `jsonapi:"attr,type"`
+
+	// The value as decoded from JSON, so a string, bool, float64,
+	// []interface{} or map[string]interface{}.
+	Value interface{}
+}
+
+// decodeRawResource decodes the value of the output, which can hold any JSON
+// value the jsonapi package can not decode into a single attribute field.
+func (o *StateVersionOutput) decodeRawResource(raw *rawResource) error {
+	o.Value = nil
+
+	v, ok := raw.Attributes["value"]
+	if !ok || len(v) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(v, &o.Value)
+}
+
+// Read a state version output by its ID.
+func (s *stateVersionOutputs) Read(ctx context.Context, outputID string) (*StateVersionOutput, error) {
+	if !validStringID(&outputID) {
+		return nil, errors.New("invalid value for state version output ID")
+	}
+
+	u := fmt.Sprintf("state-version-outputs/%s", url.QueryEscape(outputID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	svo := &StateVersionOutput{}
+	err = s.client.do(ctx, req, svo)
+	if err != nil {
+		return nil, err
+	}
+
+	return svo, nil
+}
@@ -0,0 +1,71 @@
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateVersionOutputs(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/tfe/v2/state-versions/sv-123/outputs":
+			w.Write([]byte(`{
+				"data": [
+					{"id": "wsout-1", "type": "state-version-outputs", "attributes": {"name": "vpc_id", "sensitive": false, "type": "string", "value": "vpc-123"}},
+					{"id": "wsout-2", "type": "state-version-outputs", "attributes": {"name": "subnets", "sensitive": false, "type": "array", "value": ["a", "b"]}},
+					{"id": "wsout-3", "type": "state-version-outputs", "attributes": {"name": "password", "sensitive": true, "type": "string", "value": null}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 3}}
+			}`))
+		case "GET /api/tfe/v2/state-version-outputs/wsout-4":
+			w.Write([]byte(`{"data": {"id": "wsout-4", "type": "state-version-outputs", "attributes": {
+				"name": "tags", "sensitive": false, "type": "object", "value": {"env": "prod", "count": 2}}}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when listing the outputs of a state version", func(t *testing.T) {
+		svol, err := client.StateVersions.ListOutputs(ctx, "sv-123", StateVersionOutputListOptions{})
+		require.NoError(t, err)
+		require.Len(t, svol.Items, 3)
+		assert.Equal(t, 3, svol.TotalCount)
+
+		assert.Equal(t, "vpc_id", svol.Items[0].Name)
+		assert.Equal(t, "string", svol.Items[0].Type)
+		assert.Equal(t, "vpc-123", svol.Items[0].Value)
+		assert.Equal(t, []interface{}{"a", "b"}, svol.Items[1].Value)
+
+		assert.True(t, svol.Items[2].Sensitive)
+		assert.Nil(t, svol.Items[2].Value)
+	})
+
+	t.Run("when reading a state version output", func(t *testing.T) {
+		svo, err := client.StateVersionOutputs.Read(ctx, "wsout-4")
+		require.NoError(t, err)
+		assert.Equal(t, "tags", svo.Name)
+		assert.Equal(t, map[string]interface{}{"env": "prod", "count": float64(2)}, svo.Value)
+	})
+
+	t.Run("when the state version output does not exist", func(t *testing.T) {
+		svo, err := client.StateVersionOutputs.Read(ctx, "nonexisting")
+		assert.Nil(t, svo)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without valid IDs", func(t *testing.T) {
+		svol, err := client.StateVersions.ListOutputs(ctx, badIdentifier, StateVersionOutputListOptions{})
+		assert.Nil(t, svol)
+		assert.EqualError(t, err, "invalid value for state version ID")
+
+		svo, err := client.StateVersionOutputs.Read(ctx, badIdentifier)
+		assert.Nil(t, svo)
+		assert.EqualError(t, err, "invalid value for state version output ID")
+	})
+}
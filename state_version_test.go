@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/md5"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"testing"
 	"time"
 
@@ -320,6 +322,13 @@ func TestStateVersionsCurrent(t *testing.T) {
 	t.Run("when a state version does not exist", func(t *testing.T) {
 		sv, err := client.StateVersions.Current(ctx, wTest2.ID)
 		assert.Nil(t, sv)
+		assert.Equal(t, ErrNoStateVersion, err)
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("when the workspace does not exist", func(t *testing.T) {
+		sv, err := client.StateVersions.Current(ctx, "ws-nonexisting")
+		assert.Nil(t, sv)
 		assert.Equal(t, ErrResourceNotFound, err)
 	})
 
@@ -330,6 +339,32 @@ func TestStateVersionsCurrent(t *testing.T) {
 	})
 }
 
+func TestStateVersionsCurrentWithoutState(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/workspaces/ws-123":
+			w.Write([]byte(`{"data": {"id": "ws-123", "type": "workspaces"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the workspace has no state version", func(t *testing.T) {
+		sv, err := client.StateVersions.Current(ctx, "ws-123")
+		assert.Nil(t, sv)
+		assert.Equal(t, ErrNoStateVersion, err)
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("when the workspace does not exist", func(t *testing.T) {
+		sv, err := client.StateVersions.Current(ctx, "ws-nonexisting")
+		assert.Nil(t, sv)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+}
+
 func TestStateVersionsDownload(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -361,3 +396,165 @@ func TestStateVersionsDownload(t *testing.T) {
 		assert.Equal(t, ErrResourceNotFound, err)
 	})
 }
+
+func TestStateVersionsDownloadByID(t *testing.T) {
+	reads, downloads := 0, 0
+	forbidden := false
+
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/state-versions/sv-123":
+			reads++
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			fmt.Fprintf(w, `{"data": {"id": "sv-123", "type": "state-versions", "attributes": {
+				"hosted-state-download-url": "http://%s/archivist/sv-123?token=%d"}}}`, r.Host, reads)
+		case "/archivist/sv-123":
+			downloads++
+			// Only the URL of the latest read is valid.
+			if forbidden || r.URL.Query().Get("token") != fmt.Sprint(reads) || downloads == 1 {
+				w.WriteHeader(403)
+				return
+			}
+			w.Write([]byte(`{"version": 4}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the download URL expired", func(t *testing.T) {
+		reads, downloads = 0, 0
+
+		state, err := client.StateVersions.DownloadByID(ctx, "sv-123")
+		require.NoError(t, err)
+		assert.Equal(t, `{"version": 4}`, string(state))
+		assert.Equal(t, 2, reads)
+		assert.Equal(t, 2, downloads)
+	})
+
+	t.Run("when the download is forbidden", func(t *testing.T) {
+		reads, downloads = 0, 0
+		forbidden = true
+		defer func() { forbidden = false }()
+
+		state, err := client.StateVersions.DownloadByID(ctx, "sv-123")
+		assert.Nil(t, state)
+
+		var errResp *ErrorResponse
+		require.True(t, errors.As(err, &errResp))
+		assert.Equal(t, 403, errResp.StatusCode)
+		assert.Equal(t, 2, downloads)
+	})
+
+	t.Run("when the state version does not exist", func(t *testing.T) {
+		state, err := client.StateVersions.DownloadByID(ctx, "sv-nonexisting")
+		assert.Nil(t, state)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid state version ID", func(t *testing.T) {
+		state, err := client.StateVersions.DownloadByID(ctx, badIdentifier)
+		assert.Nil(t, state)
+		assert.EqualError(t, err, "invalid value for state version ID")
+	})
+}
+
+func TestStateVersionsWaitForRun(t *testing.T) {
+	runReads, svReads := 0, 0
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/runs/run-applied":
+			runReads++
+			status := "applied"
+			if runReads == 1 {
+				status = "applying"
+			}
+			w.Write([]byte(`{"data": {"id": "run-applied", "type": "runs", "attributes": {"status": "` + status + `"},
+				"relationships": {"apply": {"data": {"id": "apply-1", "type": "applies"}}}}}`))
+		case "/api/tfe/v2/runs/run-errored":
+			w.Write([]byte(`{"data": {"id": "run-errored", "type": "runs", "attributes": {"status": "errored"}}}`))
+		case "/api/tfe/v2/runs/run-apply-errored":
+			w.Write([]byte(`{"data": {"id": "run-apply-errored", "type": "runs", "attributes": {"status": "errored"},
+				"relationships": {"apply": {"data": {"id": "apply-2", "type": "applies"}}}}}`))
+		case "/api/tfe/v2/runs/run-planned":
+			w.Write([]byte(`{"data": {"id": "run-planned", "type": "runs", "attributes": {"status": "planned", "actions": {"is-confirmable": true}}}}`))
+		case "/api/tfe/v2/runs/run-policy-override":
+			w.Write([]byte(`{"data": {"id": "run-policy-override", "type": "runs", "attributes": {"status": "policy_override"}}}`))
+		case "/api/tfe/v2/applies/apply-1":
+			w.Write([]byte(`{"data": {"id": "apply-1", "type": "applies", "attributes": {"status": "finished"},
+				"relationships": {"state-versions": {"data": [{"id": "sv-1", "type": "state-versions"}]}}}}`))
+		case "/api/tfe/v2/applies/apply-2":
+			w.Write([]byte(`{"data": {"id": "apply-2", "type": "applies", "attributes": {"status": "errored"},
+				"relationships": {"state-versions": {"data": [
+					{"id": "sv-3", "type": "state-versions"},
+					{"id": "sv-2", "type": "state-versions"}]}}}}`))
+		case "/api/tfe/v2/state-versions/sv-2":
+			w.Write([]byte(`{"data": {"id": "sv-2", "type": "state-versions", "attributes": {"serial": 4, "status": "finalized"}}}`))
+		case "/api/tfe/v2/state-versions/sv-3":
+			w.Write([]byte(`{"data": {"id": "sv-3", "type": "state-versions", "attributes": {"serial": 5, "status": "finalized"}}}`))
+		case "/api/tfe/v2/state-versions/sv-1":
+			svReads++
+			status := "finalized"
+			if svReads == 1 {
+				status = "pending"
+			}
+			w.Write([]byte(`{"data": {"id": "sv-1", "type": "state-versions", "attributes": {"serial": 2, "status": "` + status + `"}}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the state version gets finalized", func(t *testing.T) {
+		sv, err := client.StateVersions.WaitForRun(ctx, "run-applied")
+		require.NoError(t, err)
+		assert.Equal(t, "sv-1", sv.ID)
+		assert.Equal(t, StateVersionFinalized, sv.Status)
+		assert.Equal(t, 3, runReads)
+		assert.Equal(t, 2, svReads)
+	})
+
+	t.Run("when the run is not applied", func(t *testing.T) {
+		sv, err := client.StateVersions.WaitForRun(ctx, "run-errored")
+		assert.Nil(t, sv)
+		assert.EqualError(t, err, "run run-errored finished without being applied")
+	})
+
+	t.Run("when the apply errored after creating state versions", func(t *testing.T) {
+		sv, err := client.StateVersions.WaitForRun(ctx, "run-apply-errored")
+		require.NoError(t, err)
+		assert.Equal(t, "sv-3", sv.ID)
+		assert.Equal(t, int64(5), sv.Serial)
+	})
+
+	t.Run("when the run awaits confirmation", func(t *testing.T) {
+		sv, err := client.StateVersions.WaitForRun(ctx, "run-planned")
+		assert.Nil(t, sv)
+		assert.Equal(t, ErrRunAwaitingAction, err)
+	})
+
+	t.Run("when the run awaits a policy override", func(t *testing.T) {
+		sv, err := client.StateVersions.WaitForRun(ctx, "run-policy-override")
+		assert.Nil(t, sv)
+		assert.Equal(t, ErrRunAwaitingAction, err)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		runReads, svReads = 0, 0
+
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+
+		sv, err := client.StateVersions.WaitForRun(ctx, "run-applied")
+		assert.Nil(t, sv)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+
+	t.Run("with an invalid run ID", func(t *testing.T) {
+		sv, err := client.StateVersions.WaitForRun(ctx, badIdentifier)
+		assert.Nil(t, sv)
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
@@ -36,6 +36,15 @@ type teams struct {
 	client *Client
 }
 
+// TeamVisibilityType represents the visibility of a team.
+type TeamVisibilityType string
+
+// List all available team visibilities.
+const (
+	TeamVisibilityOrganization TeamVisibilityType = "organization"
+	TeamVisibilitySecret       TeamVisibilityType = "secret"
+)
+
 // TeamList represents a list of teams.
 type TeamList struct {
 	*Pagination
@@ -49,9 +58,11 @@ type Team struct {
 	OrganizationAccess *OrganizationAccess `jsonapi:"attr,organization-access"`
 	Permissions        *TeamPermissions    `jsonapi:"attr,permissions"`
 	UserCount          int                 `jsonapi:"attr,users-count"`
+	Visibility         TeamVisibilityType  `jsonapi:"attr,visibility"`
 
 	// Relations
-	Users []*User `jsonapi:"relation,users"`
+	OrganizationMemberships []*OrganizationMembership `jsonapi:"relation,organization-memberships"`
+	Users                   []*User                   `jsonapi:"relation,users"`
 }
 
 // OrganizationAccess represents the team's permissions on its organization
@@ -74,8 +85,9 @@ type TeamListOptions struct {
 
 // List all the teams of the given organization.
 func (s *teams) List(ctx context.Context, organization string, options TeamListOptions) (*TeamList, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/teams", url.QueryEscape(organization))
@@ -103,6 +115,9 @@ type TeamCreateOptions struct {
 
 	// The team's organization access
 	OrganizationAccess *OrganizationAccessOptions `jsonapi:"attr,organization-access,omitempty"`
+
+	// The visibility of the team, which defaults to secret.
+	Visibility *TeamVisibilityType `jsonapi:"attr,visibility,omitempty"`
 }
 
 // OrganizationAccessOptions represents the organization access options of a team.
@@ -112,19 +127,25 @@ type OrganizationAccessOptions struct {
 	ManageVCSSettings *bool `json:"manage-vcs-settings,omitempty"`
 }
 
-func (o TeamCreateOptions) valid() error {
+// Validate checks the team create options for errors, without making an API
+// request.
+func (o TeamCreateOptions) Validate() error {
 	if !validString(o.Name) {
-		return errors.New("name is required")
+		return validationError("name is required")
+	}
+	if o.Visibility != nil && !validTeamVisibility(*o.Visibility) {
+		return validationError("invalid value for visibility")
 	}
 	return nil
 }
 
 // Create a new team with the given options.
 func (s *teams) Create(ctx context.Context, organization string, options TeamCreateOptions) (*Team, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -177,6 +198,18 @@ type TeamUpdateOptions struct {
 
 	// The team's organization access
 	OrganizationAccess *OrganizationAccessOptions `jsonapi:"attr,organization-access,omitempty"`
+
+	// A new visibility of the team.
+	Visibility *TeamVisibilityType `jsonapi:"attr,visibility,omitempty"`
+}
+
+// Validate checks the team update options for errors, without making an API
+// request.
+func (o TeamUpdateOptions) Validate() error {
+	if o.Visibility != nil && !validTeamVisibility(*o.Visibility) {
+		return validationError("invalid value for visibility")
+	}
+	return nil
 }
 
 // Update a team by its ID.
@@ -184,6 +217,9 @@ func (s *teams) Update(ctx context.Context, teamID string, options TeamUpdateOpt
 	if !validStringID(&teamID) {
 		return nil, errors.New("invalid value for team ID")
 	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
@@ -217,3 +253,11 @@ func (s *teams) Delete(ctx context.Context, teamID string) error {
 
 	return s.client.do(ctx, req, nil)
 }
+
+func validTeamVisibility(v TeamVisibilityType) bool {
+	switch v {
+	case TeamVisibilityOrganization, TeamVisibilitySecret:
+		return true
+	}
+	return false
+}
@@ -25,8 +25,18 @@ type TeamAccesses interface {
 	// Read a team access by its ID.
 	Read(ctx context.Context, teamAccessID string) (*TeamAccess, error)
 
+	// Update the access type or the granular permissions of a team access by
+	// its ID.
+	Update(ctx context.Context, teamAccessID string, options TeamAccessUpdateOptions) (*TeamAccess, error)
+
 	// Remove team access from a workspace.
 	Remove(ctx context.Context, teamAccessID string) error
+
+	// Set reconciles the team accesses of a workspace with the given grants.
+	// Missing grants are added, grants with a different access type or
+	// different granular permissions are updated and team accesses that are
+	// not granted are removed.
+	Set(ctx context.Context, workspaceID string, grants []TeamAccessGrant) ([]*TeamAccess, error)
 }
 
 // teamAccesses implements TeamAccesses.
@@ -39,22 +49,127 @@ type AccessType string
 
 // List all available team access types.
 const (
-	AccessAdmin AccessType = "admin"
-	AccessPlan  AccessType = "plan"
-	AccessRead  AccessType = "read"
-	AccessWrite AccessType = "write"
+	AccessAdmin  AccessType = "admin"
+	AccessCustom AccessType = "custom"
+	AccessPlan   AccessType = "plan"
+	AccessRead   AccessType = "read"
+	AccessWrite  AccessType = "write"
+)
+
+func validAccessType(v AccessType) bool {
+	switch v {
+	case AccessAdmin, AccessCustom, AccessPlan, AccessRead, AccessWrite:
+		return true
+	}
+	return false
+}
+
+// RunsPermissionType represents the permissions of a team on the runs of a
+// workspace.
+type RunsPermissionType string
+
+// List all available runs permission types.
+const (
+	RunsPermissionApply RunsPermissionType = "apply"
+	RunsPermissionPlan  RunsPermissionType = "plan"
+	RunsPermissionRead  RunsPermissionType = "read"
+)
+
+func validRunsPermission(v RunsPermissionType) bool {
+	switch v {
+	case RunsPermissionApply, RunsPermissionPlan, RunsPermissionRead:
+		return true
+	}
+	return false
+}
+
+// VariablesPermissionType represents the permissions of a team on the
+// variables of a workspace.
+type VariablesPermissionType string
+
+// List all available variables permission types.
+const (
+	VariablesPermissionNone  VariablesPermissionType = "none"
+	VariablesPermissionRead  VariablesPermissionType = "read"
+	VariablesPermissionWrite VariablesPermissionType = "write"
 )
 
+func validVariablesPermission(v VariablesPermissionType) bool {
+	switch v {
+	case VariablesPermissionNone, VariablesPermissionRead, VariablesPermissionWrite:
+		return true
+	}
+	return false
+}
+
+// StateVersionsPermissionType represents the permissions of a team on the
+// state versions of a workspace.
+type StateVersionsPermissionType string
+
+// List all available state versions permission types.
+const (
+	StateVersionsPermissionNone        StateVersionsPermissionType = "none"
+	StateVersionsPermissionRead        StateVersionsPermissionType = "read"
+	StateVersionsPermissionReadOutputs StateVersionsPermissionType = "read-outputs"
+	StateVersionsPermissionWrite       StateVersionsPermissionType = "write"
+)
+
+func validStateVersionsPermission(v StateVersionsPermissionType) bool {
+	switch v {
+	case StateVersionsPermissionNone, StateVersionsPermissionRead,
+		StateVersionsPermissionReadOutputs, StateVersionsPermissionWrite:
+		return true
+	}
+	return false
+}
+
+// validTeamAccessPermissions checks the granular permissions of a team
+// access, which can only be set together with custom access. A nil access
+// means the access is not changed.
+func validTeamAccessPermissions(access *AccessType, runs *RunsPermissionType, variables *VariablesPermissionType, stateVersions *StateVersionsPermissionType) error {
+	custom := access == nil || *access == AccessCustom
+
+	if runs != nil {
+		if !custom {
+			return validationError("runs can only be set when access is custom")
+		}
+		if !validRunsPermission(*runs) {
+			return validationError("invalid value for runs")
+		}
+	}
+	if variables != nil {
+		if !custom {
+			return validationError("variables can only be set when access is custom")
+		}
+		if !validVariablesPermission(*variables) {
+			return validationError("invalid value for variables")
+		}
+	}
+	if stateVersions != nil {
+		if !custom {
+			return validationError("state versions can only be set when access is custom")
+		}
+		if !validStateVersionsPermission(*stateVersions) {
+			return validationError("invalid value for state versions")
+		}
+	}
+	return nil
+}
+
 // TeamAccessList represents a list of team accesses.
 type TeamAccessList struct {
 	*Pagination
 	Items []*TeamAccess
 }
 
-// TeamAccess represents the workspace access for a team.
+// TeamAccess represents the workspace access for a team. The granular
+// permissions follow from the access type, unless the access is custom.
 type TeamAccess struct {
-	ID     string     `jsonapi:"primary,team-workspaces"`
-	Access AccessType `jsonapi:"attr,access"`
+	ID            string                      `jsonapi:"primary,team-workspaces"`
+	Access        AccessType                  `jsonapi:"attr,access"`
+	Runs          RunsPermissionType          `jsonapi:"attr,runs"`
+	Variables     VariablesPermissionType     `jsonapi:"attr,variables"`
+	StateVersions StateVersionsPermissionType `jsonapi:"attr,state-versions"`
 
 	// Relations
 	Team      *Team      `jsonapi:"relation,team"`
@@ -67,19 +182,21 @@ type TeamAccessListOptions struct {
 	WorkspaceID *string `url:"filter[workspace][id],omitempty"`
 }
 
-func (o TeamAccessListOptions) valid() error {
+// Validate checks the team access list options for errors, without making an
+// API request.
+func (o TeamAccessListOptions) Validate() error {
 	if !validString(o.WorkspaceID) {
-		return errors.New("workspace ID is required")
+		return validationError("workspace ID is required")
 	}
 	if !validStringID(o.WorkspaceID) {
-		return errors.New("invalid value for workspace ID")
+		return validationError("invalid value for workspace ID")
 	}
 	return nil
 }
 
 // List all the team accesses for a given workspace.
 func (s *teamAccesses) List(ctx context.Context, options TeamAccessListOptions) (*TeamAccessList, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -105,6 +222,15 @@ type TeamAccessAddOptions struct {
 	// The type of access to grant.
 	Access *AccessType `jsonapi:"attr,access"`
 
+	// The permissions on the runs, only with custom access.
+	Runs *RunsPermissionType `jsonapi:"attr,runs,omitempty"`
+
+	// The permissions on the variables, only with custom access.
+	Variables *VariablesPermissionType `jsonapi:"attr,variables,omitempty"`
+
+	// The permissions on the state versions, only with custom access.
+	StateVersions *StateVersionsPermissionType `jsonapi:"attr,state-versions,omitempty"`
+
 	// The team to add to the workspace
 	Team *Team `jsonapi:"relation,team"`
 
@@ -112,22 +238,30 @@ type TeamAccessAddOptions struct {
 	Workspace *Workspace `jsonapi:"relation,workspace"`
 }
 
-func (o TeamAccessAddOptions) valid() error {
+// Validate checks the team access add options for errors, without making an API
+// request.
+func (o TeamAccessAddOptions) Validate() error {
 	if o.Access == nil {
-		return errors.New("access is required")
+		return validationError("access is required")
+	}
+	if !validAccessType(*o.Access) {
+		return validationError("invalid value for access")
+	}
+	if err := validTeamAccessPermissions(o.Access, o.Runs, o.Variables, o.StateVersions); err != nil {
+		return err
 	}
 	if o.Team == nil {
-		return errors.New("team is required")
+		return validationError("team is required")
 	}
 	if o.Workspace == nil {
-		return errors.New("workspace is required")
+		return validationError("workspace is required")
 	}
 	return nil
 }
 
 // Add team access for a workspace.
 func (s *teamAccesses) Add(ctx context.Context, options TeamAccessAddOptions) (*TeamAccess, error) {
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -169,6 +303,65 @@ func (s *teamAccesses) Read(ctx context.Context, teamAccessID string) (*TeamAcce
 	return ta, nil
 }
 
+// TeamAccessUpdateOptions represents the options for updating team access.
+type TeamAccessUpdateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,team-workspaces"`
+
+	// The type of access to grant.
+	Access *AccessType `jsonapi:"attr,access,omitempty"`
+
+	// The permissions on the runs, only with custom access.
+	Runs *RunsPermissionType `jsonapi:"attr,runs,omitempty"`
+
+	// The permissions on the variables, only with custom access.
+	Variables *VariablesPermissionType `jsonapi:"attr,variables,omitempty"`
+
+	// The permissions on the state versions, only with custom access.
+	StateVersions *StateVersionsPermissionType `jsonapi:"attr,state-versions,omitempty"`
+}
+
+// Validate checks the team access update options for errors, without making an
+// API request. The access can be left out when only the granular permissions
+// of a custom team access are updated.
+func (o TeamAccessUpdateOptions) Validate() error {
+	if o.Access == nil && o.Runs == nil && o.Variables == nil && o.StateVersions == nil {
+		return validationError("access is required")
+	}
+	if o.Access != nil && !validAccessType(*o.Access) {
+		return validationError("invalid value for access")
+	}
+	return validTeamAccessPermissions(o.Access, o.Runs, o.Variables, o.StateVersions)
+}
+
+// Update the access type or the granular permissions of a team access by its
+// ID.
+func (s *teamAccesses) Update(ctx context.Context, teamAccessID string, options TeamAccessUpdateOptions) (*TeamAccess, error) {
+	if !validStringID(&teamAccessID) {
+		return nil, errors.New("invalid value for team access ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("team-workspaces/%s", url.QueryEscape(teamAccessID))
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	ta := &TeamAccess{}
+	err = s.client.do(ctx, req, ta)
+	if err != nil {
+		return nil, err
+	}
+
+	return ta, nil
+}
+
 // Remove team access from a workspace.
 func (s *teamAccesses) Remove(ctx context.Context, teamAccessID string) error {
 	if !validStringID(&teamAccessID) {
@@ -183,3 +376,132 @@ func (s *teamAccesses) Remove(ctx context.Context, teamAccessID string) error {
 
 	return s.client.do(ctx, req, nil)
 }
+
+// TeamAccessGrant represents the desired access of a team to a workspace.
+type TeamAccessGrant struct {
+	// The ID of the team to grant access to.
+	TeamID string
+
+	// The type of access to grant.
+	Access AccessType
+
+	// The permissions on the runs, only with custom access.
+	Runs *RunsPermissionType
+
+	// The permissions on the variables, only with custom access.
+	Variables *VariablesPermissionType
+
+	// The permissions on the state versions, only with custom access.
+	StateVersions *StateVersionsPermissionType
+}
+
+// matches reports whether the team access already has the access type and
+// the granular permissions of the grant. Permissions left out of the grant
+// are not compared.
+func (g TeamAccessGrant) matches(ta *TeamAccess) bool {
+	return ta.Access == g.Access &&
+		(g.Runs == nil || *g.Runs == ta.Runs) &&
+		(g.Variables == nil || *g.Variables == ta.Variables) &&
+		(g.StateVersions == nil || *g.StateVersions == ta.StateVersions)
+}
+
+func validTeamAccessGrants(grants []TeamAccessGrant) error {
+	seen := make(map[string]bool, len(grants))
+	for _, g := range grants {
+		if !validStringID(&g.TeamID) {
+			return errors.New("invalid value for team ID")
+		}
+		if !validAccessType(g.Access) {
+			return errors.New("invalid value for access")
+		}
+		if err := validTeamAccessPermissions(&g.Access, g.Runs, g.Variables, g.StateVersions); err != nil {
+			return err
+		}
+		if seen[g.TeamID] {
+			return fmt.Errorf("team %s is granted access more than once", g.TeamID)
+		}
+		seen[g.TeamID] = true
+	}
+	return nil
+}
+
+// Set reconciles the team accesses of a workspace with the given grants.
+// Missing grants are added, grants with a different access type or different
+// granular permissions are updated and team accesses that are not granted are
+// removed. It returns the team
+// accesses of the workspace after reconciliation, in the order of the grants.
+func (s *teamAccesses) Set(ctx context.Context, workspaceID string, grants []TeamAccessGrant) ([]*TeamAccess, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if err := validTeamAccessGrants(grants); err != nil {
+		return nil, err
+	}
+
+	// Index the current team accesses of the workspace by team ID.
+	current := make(map[string]*TeamAccess)
+	err := listPages(0, func(pageNumber int) (*Pagination, bool, error) {
+		tal, err := s.List(ctx, TeamAccessListOptions{
+			ListOptions: ListOptions{PageNumber: pageNumber},
+			WorkspaceID: &workspaceID,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, ta := range tal.Items {
+			if ta.Team != nil {
+				current[ta.Team.ID] = ta
+			}
+		}
+		return tal.Pagination, false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*TeamAccess
+	for _, g := range grants {
+		access := g.Access
+
+		ta, ok := current[g.TeamID]
+		switch {
+		case !ok:
+			added, err := s.Add(ctx, TeamAccessAddOptions{
+				Access:        &access,
+				Runs:          g.Runs,
+				Variables:     g.Variables,
+				StateVersions: g.StateVersions,
+				Team:          &Team{ID: g.TeamID},
+				Workspace:     &Workspace{ID: workspaceID},
+			})
+			if err != nil {
+				return nil, err
+			}
+			ta = added
+		case !g.matches(ta):
+			updated, err := s.Update(ctx, ta.ID, TeamAccessUpdateOptions{
+				Access:        &access,
+				Runs:          g.Runs,
+				Variables:     g.Variables,
+				StateVersions: g.StateVersions,
+			})
+			if err != nil {
+				return nil, err
+			}
+			ta = updated
+		}
+
+		delete(current, g.TeamID)
+		result = append(result, ta)
+	}
+
+	// Remove the team accesses that are no longer granted.
+	for _, ta := range current {
+		if err := s.Remove(ctx, ta.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -2,6 +2,8 @@ package tfe
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -109,6 +111,24 @@ func TestTeamAccessesAdd(t *testing.T) {
 		}
 	})
 
+	t.Run("with custom access", func(t *testing.T) {
+		wCustom, _ := createWorkspace(t, client, orgTest)
+
+		ta, err := client.TeamAccess.Add(ctx, TeamAccessAddOptions{
+			Access:        Access(AccessCustom),
+			Runs:          RunsPermission(RunsPermissionPlan),
+			Variables:     VariablesPermission(VariablesPermissionRead),
+			StateVersions: StateVersionsPermission(StateVersionsPermissionReadOutputs),
+			Team:          tmTest,
+			Workspace:     wCustom,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, AccessCustom, ta.Access)
+		assert.Equal(t, RunsPermissionPlan, ta.Runs)
+		assert.Equal(t, VariablesPermissionRead, ta.Variables)
+		assert.Equal(t, StateVersionsPermissionReadOutputs, ta.StateVersions)
+	})
+
 	t.Run("when the team already has access", func(t *testing.T) {
 		options := TeamAccessAddOptions{
 			Access:    Access(AccessAdmin),
@@ -184,6 +204,187 @@ func TestTeamAccessesRead(t *testing.T) {
 	})
 }
 
+func TestTeamAccessesUpdate(t *testing.T) {
+	t.Skip("Unsupported resource")
+	client := testClient(t)
+	ctx := context.Background()
+
+	taTest, taTestCleanup := createTeamAccess(t, client, nil, nil, nil)
+	defer taTestCleanup()
+
+	t.Run("with valid options", func(t *testing.T) {
+		ta, err := client.TeamAccess.Update(ctx, taTest.ID, TeamAccessUpdateOptions{
+			Access: Access(AccessRead),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, AccessRead, ta.Access)
+	})
+
+	t.Run("when options is missing access", func(t *testing.T) {
+		ta, err := client.TeamAccess.Update(ctx, taTest.ID, TeamAccessUpdateOptions{})
+		assert.Nil(t, ta)
+		assert.EqualError(t, err, "access is required")
+	})
+
+	t.Run("with an invalid access", func(t *testing.T) {
+		ta, err := client.TeamAccess.Update(ctx, taTest.ID, TeamAccessUpdateOptions{
+			Access: Access("owner"),
+		})
+		assert.Nil(t, ta)
+		assert.EqualError(t, err, "invalid value for access")
+	})
+
+	t.Run("without a valid team access ID", func(t *testing.T) {
+		ta, err := client.TeamAccess.Update(ctx, badIdentifier, TeamAccessUpdateOptions{
+			Access: Access(AccessRead),
+		})
+		assert.Nil(t, ta)
+		assert.EqualError(t, err, "invalid value for team access ID")
+	})
+}
+
+func TestTeamAccessesSet(t *testing.T) {
+	var requests []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{
+				"data": [
+					{"id": "tws-1", "type": "team-workspaces", "attributes": {"access": "read"}, "relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}},
+					{"id": "tws-2", "type": "team-workspaces", "attributes": {"access": "write"}, "relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 2}}
+			}`))
+		case "POST":
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "tws-3", "type": "team-workspaces", "attributes": {"access": "admin"}, "relationships": {"team": {"data": {"id": "team-3", "type": "teams"}}}}}`))
+		case "PATCH":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Contains(t, string(body), `"access":"write"`)
+			w.Write([]byte(`{"data": {"id": "tws-1", "type": "team-workspaces", "attributes": {"access": "write"}, "relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}}`))
+		case "DELETE":
+			w.WriteHeader(204)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("with grants to add, update and remove", func(t *testing.T) {
+		requests = nil
+
+		tas, err := client.TeamAccess.Set(ctx, "ws-123", []TeamAccessGrant{
+			{TeamID: "team-1", Access: AccessWrite},
+			{TeamID: "team-3", Access: AccessAdmin},
+		})
+		require.NoError(t, err)
+		require.Len(t, tas, 2)
+		assert.Equal(t, "tws-1", tas[0].ID)
+		assert.Equal(t, AccessWrite, tas[0].Access)
+		assert.Equal(t, "tws-3", tas[1].ID)
+		assert.Equal(t, AccessAdmin, tas[1].Access)
+
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/team-workspaces",
+			"PATCH /api/tfe/v2/team-workspaces/tws-1",
+			"POST /api/tfe/v2/team-workspaces",
+			"DELETE /api/tfe/v2/team-workspaces/tws-2",
+		}, requests)
+	})
+
+	t.Run("when a team is granted access more than once", func(t *testing.T) {
+		tas, err := client.TeamAccess.Set(ctx, "ws-123", []TeamAccessGrant{
+			{TeamID: "team-1", Access: AccessWrite},
+			{TeamID: "team-1", Access: AccessRead},
+		})
+		assert.Nil(t, tas)
+		assert.EqualError(t, err, "team team-1 is granted access more than once")
+	})
+
+	t.Run("with an invalid access", func(t *testing.T) {
+		tas, err := client.TeamAccess.Set(ctx, "ws-123", []TeamAccessGrant{
+			{TeamID: "team-1", Access: "owner"},
+		})
+		assert.Nil(t, tas)
+		assert.EqualError(t, err, "invalid value for access")
+	})
+
+	t.Run("with permissions without custom access", func(t *testing.T) {
+		tas, err := client.TeamAccess.Set(ctx, "ws-123", []TeamAccessGrant{
+			{TeamID: "team-1", Access: AccessWrite, Runs: RunsPermission(RunsPermissionApply)},
+		})
+		assert.Nil(t, tas)
+		assert.EqualError(t, err, "runs can only be set when access is custom")
+	})
+
+	t.Run("with an invalid team ID", func(t *testing.T) {
+		tas, err := client.TeamAccess.Set(ctx, "ws-123", []TeamAccessGrant{
+			{TeamID: badIdentifier, Access: AccessRead},
+		})
+		assert.Nil(t, tas)
+		assert.EqualError(t, err, "invalid value for team ID")
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		tas, err := client.TeamAccess.Set(ctx, badIdentifier, nil)
+		assert.Nil(t, tas)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestTeamAccessesSetCustom(t *testing.T) {
+	var requests []string
+	var body string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{
+				"data": [
+					{"id": "tws-1", "type": "team-workspaces", "attributes": {"access": "custom", "runs": "plan", "variables": "read", "state-versions": "read-outputs"}, "relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 1}}
+			}`))
+		case "PATCH":
+			b, _ := ioutil.ReadAll(r.Body)
+			body = string(b)
+			w.Write([]byte(`{"data": {"id": "tws-1", "type": "team-workspaces", "attributes": {"access": "custom", "runs": "apply", "variables": "read", "state-versions": "read-outputs"}, "relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}}`))
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the permissions already match", func(t *testing.T) {
+		requests = nil
+
+		tas, err := client.TeamAccess.Set(ctx, "ws-123", []TeamAccessGrant{
+			{TeamID: "team-1", Access: AccessCustom, Runs: RunsPermission(RunsPermissionPlan)},
+		})
+		require.NoError(t, err)
+		require.Len(t, tas, 1)
+		assert.Equal(t, []string{"GET /api/tfe/v2/team-workspaces"}, requests)
+	})
+
+	t.Run("when a permission differs", func(t *testing.T) {
+		requests = nil
+
+		tas, err := client.TeamAccess.Set(ctx, "ws-123", []TeamAccessGrant{
+			{TeamID: "team-1", Access: AccessCustom, Runs: RunsPermission(RunsPermissionApply)},
+		})
+		require.NoError(t, err)
+		require.Len(t, tas, 1)
+		assert.Equal(t, RunsPermissionApply, tas[0].Runs)
+		assert.Contains(t, body, `"runs":"apply"`)
+		assert.NotContains(t, body, `"variables"`)
+		assert.Equal(t, []string{
+			"GET /api/tfe/v2/team-workspaces",
+			"PATCH /api/tfe/v2/team-workspaces/tws-1",
+		}, requests)
+	})
+}
+
 func TestTeamAccessesRemove(t *testing.T) {
 	t.Skip("Unsupported resource")
 	client := testClient(t)
@@ -216,3 +417,56 @@ func TestTeamAccessesRemove(t *testing.T) {
 		assert.EqualError(t, err, "invalid value for team access ID")
 	})
 }
+
+func TestTeamAccessOptionsValidate(t *testing.T) {
+	t.Run("when adding granular permissions without custom access", func(t *testing.T) {
+		err := TeamAccessAddOptions{
+			Access:    Access(AccessWrite),
+			Runs:      RunsPermission(RunsPermissionApply),
+			Team:      &Team{ID: "team-123"},
+			Workspace: &Workspace{ID: "ws-123"},
+		}.Validate()
+		assert.EqualError(t, err, "runs can only be set when access is custom")
+	})
+
+	t.Run("when adding an invalid granular permission", func(t *testing.T) {
+		err := TeamAccessAddOptions{
+			Access:        Access(AccessCustom),
+			StateVersions: StateVersionsPermission("delete"),
+			Team:          &Team{ID: "team-123"},
+			Workspace:     &Workspace{ID: "ws-123"},
+		}.Validate()
+		assert.EqualError(t, err, "invalid value for state versions")
+	})
+
+	t.Run("when adding an invalid access", func(t *testing.T) {
+		err := TeamAccessAddOptions{
+			Access:    Access("owner"),
+			Team:      &Team{ID: "team-123"},
+			Workspace: &Workspace{ID: "ws-123"},
+		}.Validate()
+		assert.EqualError(t, err, "invalid value for access")
+	})
+
+	t.Run("when updating only granular permissions", func(t *testing.T) {
+		err := TeamAccessUpdateOptions{
+			Variables: VariablesPermission(VariablesPermissionWrite),
+		}.Validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("when updating granular permissions without custom access", func(t *testing.T) {
+		err := TeamAccessUpdateOptions{
+			Access:    Access(AccessRead),
+			Variables: VariablesPermission(VariablesPermissionWrite),
+		}.Validate()
+		assert.EqualError(t, err, "variables can only be set when access is custom")
+	})
+
+	t.Run("when updating an invalid granular permission", func(t *testing.T) {
+		err := TeamAccessUpdateOptions{
+			Runs: RunsPermission("destroy"),
+		}.Validate()
+		assert.EqualError(t, err, "invalid value for runs")
+	})
+}
@@ -19,6 +19,10 @@ type TeamMembers interface {
 	// List all members of a team.
 	List(ctx context.Context, teamID string) ([]*User, error)
 
+	// ListOrganizationMemberships lists the organization memberships of all
+	// members of a team.
+	ListOrganizationMemberships(ctx context.Context, teamID string) ([]*OrganizationMembership, error)
+
 	// Add multiple users to a team.
 	Add(ctx context.Context, teamID string, options TeamMemberAddOptions) error
 
@@ -62,17 +66,47 @@ func (s *teamMembers) List(ctx context.Context, teamID string) ([]*User, error)
 	return t.Users, nil
 }
 
+// ListOrganizationMemberships lists the organization memberships of all members
+// of a team, including their status and email, with the users included.
+func (s *teamMembers) ListOrganizationMemberships(ctx context.Context, teamID string) ([]*OrganizationMembership, error) {
+	if !validStringID(&teamID) {
+		return nil, errors.New("invalid value for team ID")
+	}
+
+	options := struct {
+		Include string `url:"include"`
+	}{
+		Include: "organization-memberships,organization-memberships.user",
+	}
+
+	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
+	req, err := s.client.newRequest("GET", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Team{}
+	err = s.client.do(ctx, req, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.OrganizationMemberships, nil
+}
+
 // TeamMemberAddOptions represents the options for adding team members.
 type TeamMemberAddOptions struct {
 	Usernames []string
 }
 
-func (o *TeamMemberAddOptions) valid() error {
+// Validate checks the team member add options for errors, without making an API
+// request.
+func (o TeamMemberAddOptions) Validate() error {
 	if o.Usernames == nil {
-		return errors.New("usernames is required")
+		return validationError("usernames is required")
 	}
 	if len(o.Usernames) == 0 {
-		return errors.New("invalid value for usernames")
+		return validationError("invalid value for usernames")
 	}
 	return nil
 }
@@ -82,7 +116,7 @@ func (s *teamMembers) Add(ctx context.Context, teamID string, options TeamMember
 	if !validStringID(&teamID) {
 		return errors.New("invalid value for team ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
@@ -105,12 +139,14 @@ type TeamMemberRemoveOptions struct {
 	Usernames []string
 }
 
-func (o *TeamMemberRemoveOptions) valid() error {
+// Validate checks the team member remove options for errors, without making an
+// API request.
+func (o TeamMemberRemoveOptions) Validate() error {
 	if o.Usernames == nil {
-		return errors.New("usernames is required")
+		return validationError("usernames is required")
 	}
 	if len(o.Usernames) == 0 {
-		return errors.New("invalid value for usernames")
+		return validationError("invalid value for usernames")
 	}
 	return nil
 }
@@ -120,7 +156,7 @@ func (s *teamMembers) Remove(ctx context.Context, teamID string, options TeamMem
 	if !validStringID(&teamID) {
 		return errors.New("invalid value for team ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return err
 	}
 
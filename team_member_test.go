@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -45,6 +46,47 @@ func TestTeamMembersList(t *testing.T) {
 	})
 }
 
+func TestTeamMembersListOrganizationMemberships(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/teams/team-123", r.URL.Path)
+		assert.Equal(t, "organization-memberships,organization-memberships.user", r.URL.Query().Get("include"))
+		w.Write([]byte(`{
+			"data": {
+				"id": "team-123",
+				"type": "teams",
+				"relationships": {
+					"organization-memberships": {"data": [
+						{"id": "ou-1", "type": "organization-memberships"},
+						{"id": "ou-2", "type": "organization-memberships"}
+					]}
+				}
+			},
+			"included": [
+				{"id": "ou-1", "type": "organization-memberships", "attributes": {"email": "alice@example.com", "status": "active"}},
+				{"id": "ou-2", "type": "organization-memberships", "attributes": {"email": "bob@example.com", "status": "invited"}}
+			]
+		}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with valid options", func(t *testing.T) {
+		ms, err := client.TeamMembers.ListOrganizationMemberships(ctx, "team-123")
+		require.NoError(t, err)
+		require.Len(t, ms, 2)
+		assert.Equal(t, "alice@example.com", ms[0].Email)
+		assert.Equal(t, OrganizationMembershipStatus(OrganizationMembershipActive), ms[0].Status)
+		assert.Equal(t, "bob@example.com", ms[1].Email)
+		assert.Equal(t, OrganizationMembershipStatus(OrganizationMembershipInvited), ms[1].Status)
+	})
+
+	t.Run("when the team ID is invalid", func(t *testing.T) {
+		ms, err := client.TeamMembers.ListOrganizationMemberships(ctx, badIdentifier)
+		assert.Nil(t, ms)
+		assert.EqualError(t, err, "invalid value for team ID")
+	})
+}
+
 func TestTeamMembersAdd(t *testing.T) {
 	t.Skip("Unsupported resource")
 	client := testClient(t)
@@ -2,6 +2,8 @@ package tfe
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -100,6 +102,59 @@ func TestTeamsCreate(t *testing.T) {
 	})
 }
 
+func TestTeamsCreateWithAccessAndVisibility(t *testing.T) {
+	var body string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/tfe/v2/organizations/acme/teams", r.URL.Path)
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(201)
+		w.Write([]byte(`{"data": {"id": "team-123", "type": "teams", "attributes": {
+			"name": "platform", "visibility": "organization", "users-count": 0,
+			"organization-access": {"manage-policies": true, "manage-workspaces": true, "manage-vcs-settings": false}}}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with organization access and visibility", func(t *testing.T) {
+		tm, err := client.Teams.Create(ctx, "acme", TeamCreateOptions{
+			Name: String("platform"),
+			OrganizationAccess: &OrganizationAccessOptions{
+				ManagePolicies:   Bool(true),
+				ManageWorkspaces: Bool(true),
+			},
+			Visibility: TeamVisibility(TeamVisibilityOrganization),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "team-123", tm.ID)
+		assert.Equal(t, TeamVisibilityOrganization, tm.Visibility)
+		require.NotNil(t, tm.OrganizationAccess)
+		assert.True(t, tm.OrganizationAccess.ManagePolicies)
+		assert.True(t, tm.OrganizationAccess.ManageWorkspaces)
+
+		assert.Contains(t, body, `"visibility":"organization"`)
+		assert.Contains(t, body, `"organization-access":{"manage-policies":true,"manage-workspaces":true}`)
+	})
+
+	t.Run("with an invalid visibility", func(t *testing.T) {
+		tm, err := client.Teams.Create(ctx, "acme", TeamCreateOptions{
+			Name:       String("platform"),
+			Visibility: TeamVisibility("public"),
+		})
+		assert.Nil(t, tm)
+		assert.EqualError(t, err, "invalid value for visibility")
+	})
+
+	t.Run("when updating with an invalid visibility", func(t *testing.T) {
+		tm, err := client.Teams.Update(ctx, "team-123", TeamUpdateOptions{
+			Visibility: TeamVisibility("public"),
+		})
+		assert.Nil(t, tm)
+		assert.EqualError(t, err, "invalid value for visibility")
+	})
+}
+
 func TestTeamsRead(t *testing.T) {
 	t.Skip("Unsupported resource - internal profile")
 	client := testClient(t)
@@ -32,7 +32,8 @@ type teamTokens struct {
 	client *Client
 }
 
-// TeamToken represents a Terraform Enterprise team token.
+// TeamToken represents a Terraform Enterprise team token. Token is only
+// set in the response of Generate.
 type TeamToken struct {
 	ID          string    `jsonapi:"primary,authentication-tokens"`
 	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
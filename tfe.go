@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math/rand"
 	"net/http"
 	"net/url"
@@ -28,6 +29,8 @@ const (
 	headerRateLimit = "X-RateLimit-Limit"
 	headerRateReset = "X-RateLimit-Reset"
 
+	headerRetryAfter = "Retry-After"
+
 	// DefaultAddress of Terraform Enterprise.
 	DefaultAddress = "https://app.terraform.io"
 	// DefaultBasePath on which the API is served.
@@ -39,20 +42,157 @@ const (
 var (
 	// ErrWorkspaceLocked is returned when trying to lock a
 	// locked workspace.
-	ErrWorkspaceLocked = errors.New("workspace already locked")
+	ErrWorkspaceLocked error = conflictError("workspace already locked")
 	// ErrWorkspaceNotLocked is returned when trying to unlock
 	// a unlocked workspace.
-	ErrWorkspaceNotLocked = errors.New("workspace already unlocked")
+	ErrWorkspaceNotLocked error = conflictError("workspace already unlocked")
+
+	// ErrInsufficientPermissions is returned when the token is not
+	// allowed to lock, unlock or force-unlock a workspace.
+	ErrInsufficientPermissions = errors.New("insufficient permissions")
 
 	// ErrUnauthorized is returned when a receiving a 401.
 	ErrUnauthorized = errors.New("unauthorized")
 	// ErrResourceNotFound is returned when a receiving a 404.
 	ErrResourceNotFound = errors.New("resource not found")
+	// ErrConflict is returned when receiving a 409 that isn't mapped to
+	// a more specific error. The more specific errors, like
+	// ErrWorkspaceLocked, and an *ErrorResponse for a 409 match it as well
+	// when using errors.Is.
+	ErrConflict = errors.New("conflict")
+	// ErrResourceAlreadyExists is returned when receiving a 422
+	// because a resource with the same name already exists.
+	ErrResourceAlreadyExists = errors.New("resource already exists")
+
+	// ErrNoConfigurationVersion is returned when creating a run from
+	// the latest configuration of a workspace that has no uploaded
+	// configuration version yet.
+	ErrNoConfigurationVersion = errors.New("workspace has no uploaded configuration version")
+
+	// ErrRunTriggerCycle is returned when creating a run trigger of which
+	// the source workspace is the workspace itself, or, when checking for
+	// cycles, a workspace that is already triggered by the workspace.
+	ErrRunTriggerCycle = errors.New("run trigger would create a cycle")
+
+	// ErrRunAwaitingAction is returned when waiting for the state version
+	// of a run that waits to be confirmed or for a policy override, so it
+	// won't be applied without further action.
+	ErrRunAwaitingAction = errors.New("run is awaiting confirmation or a policy override")
+
+	// ErrNoStateVersion is returned when reading the current state
+	// version of a workspace that has no state version yet. It wraps
+	// ErrResourceNotFound, as that is what the API returns.
+	ErrNoStateVersion = fmt.Errorf("workspace has no state version: %w", ErrResourceNotFound)
+
+	// ErrNoCostEstimate is returned when reading the cost estimate of a
+	// run that has none. It wraps ErrResourceNotFound.
+	ErrNoCostEstimate = fmt.Errorf("run has no cost estimate: %w", ErrResourceNotFound)
+
+	// ErrRunActionNotAllowed is returned when receiving a 409 when
+	// applying, canceling or discarding a run, because the current
+	// status of the run doesn't allow the action.
+	ErrRunActionNotAllowed error = conflictError("run action not allowed in the current status of the run")
+
+	// ErrPolicyCheckNotOverridable is returned when receiving a 409 when
+	// overriding a policy check that didn't fail a soft-mandatory policy,
+	// or that was already overridden.
+	ErrPolicyCheckNotOverridable error = conflictError("policy check is not overridable")
+
+	// ErrFeatureNotEnabled is returned when receiving a 403 or 422 from a
+	// cost estimate endpoint because cost estimation is not enabled for
+	// the organization.
+	ErrFeatureNotEnabled = errors.New("feature not enabled")
+
+	// ErrInvalidOptions is matched by the errors returned by the Validate
+	// methods of the options when using errors.Is. The error message still
+	// tells which option is invalid.
+	ErrInvalidOptions = errors.New("invalid options")
+
+	// ErrUnreachable is returned by Ping when the API could not be
+	// reached, for example because of a DNS or connection error.
+	ErrUnreachable = errors.New("unable to reach the API")
+	// ErrServiceUnavailable is returned by Ping when the API responds
+	// with a server error.
+	ErrServiceUnavailable = errors.New("service unavailable")
 )
 
+// IsNotFound reports whether err is, or wraps, ErrResourceNotFound.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrResourceNotFound)
+}
+
+// conflictError is a sentinel error for a specific 409 response, which
+// matches ErrConflict when using errors.Is.
+type conflictError string
+
+func (e conflictError) Error() string {
+	return string(e)
+}
+
+// Is reports whether target is ErrConflict.
+func (e conflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// validationError is returned when options fail to validate, which matches
+// ErrInvalidOptions when using errors.Is.
+type validationError string
+
+func (e validationError) Error() string {
+	return string(e)
+}
+
+// Is reports whether target is ErrInvalidOptions.
+func (e validationError) Is(target error) bool {
+	return target == ErrInvalidOptions
+}
+
+// ErrorResponse is returned when the API responds with an error that isn't
+// mapped to one of the sentinel errors. It carries the errors of the payload,
+// so callers can inspect them using errors.As.
+type ErrorResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Status is the HTTP status of the response, like "403 Forbidden".
+	Status string
+
+	// Errors are the errors of the jsonapi error payload, which are empty
+	// when the response has no such payload.
+	Errors []*jsonapi.ErrorObject
+}
+
+// Error formats the titles and details of all errors of the response, or
+// returns the status when there are no errors.
+func (e *ErrorResponse) Error() string {
+	if len(e.Errors) == 0 {
+		return e.Status
+	}
+
+	var errs []string
+	for _, obj := range e.Errors {
+		if obj.Detail == "" {
+			errs = append(errs, obj.Title)
+		} else {
+			errs = append(errs, fmt.Sprintf("%s\n\n%s", obj.Title, obj.Detail))
+		}
+	}
+	return strings.Join(errs, "\n")
+}
+
+// Is reports whether the response matches the sentinel error of its status
+// code, so a 409 response matches ErrConflict.
+func (e *ErrorResponse) Is(target error) bool {
+	return target == ErrConflict && e.StatusCode == 409
+}
+
 // RetryLogHook allows a function to run before each retry.
 type RetryLogHook func(attemptNum int, resp *http.Response)
 
+// WarningHook allows a function to run for each warning returned by the API,
+// for example a deprecation notice for an endpoint that is being sunset.
+type WarningHook func(method, path, warning string)
+
 // Config provides configuration details to the API client.
 type Config struct {
 	// The address of the Terraform Enterprise API.
@@ -72,6 +212,88 @@ type Config struct {
 
 	// RetryLogHook is invoked each time a request is retried.
 	RetryLogHook RetryLogHook
+
+	// RetryMax is the maximum number of times a single request is retried.
+	// It bounds the retries of rate limited requests, which only wait for
+	// the rate limit to reset and would otherwise fail during bulk
+	// operations, so it defaults to 30.
+	RetryMax int
+
+	// RetryServerErrorMax is the maximum number of times a single request
+	// is retried after a server or connection error, unless retrying those
+	// is disabled with RetryServerErrors. It defaults to 3.
+	RetryServerErrorMax int
+
+	// RetryBaseDelay is the time to wait before the first retry of a
+	// request that failed with a server error. The time doubles with every
+	// retry, up to 30 seconds, and some jitter is added. It defaults to
+	// 700 milliseconds.
+	RetryBaseDelay time.Duration
+
+	// WarningHook is invoked for each warning returned by the API, either
+	// in the X-TFE-Deprecation header or in the meta.warnings block of the
+	// response. Warnings are dropped when no hook is set.
+	WarningHook WarningHook
+
+	// EntitlementsCacheTTL enables caching the entitlements of organizations
+	// for the given duration. Entitlements rarely change, so this saves a
+	// request for every feature check. Caching is disabled when zero.
+	EntitlementsCacheTTL time.Duration
+
+	// Metrics is used to observe the requests and retries made by the
+	// client. It defaults to a no-op implementation.
+	Metrics Metrics
+
+	// Decoder is used to decode the JSON:API responses. It defaults to a
+	// reflection based decoder and can be replaced by a faster or streaming
+	// implementation when handling very large responses.
+	Decoder Decoder
+
+	// DefaultOrganization is used by the methods that take an organization
+	// when they are called with an empty organization name.
+	DefaultOrganization string
+}
+
+// Metrics can be implemented to collect metrics about the API requests, for
+// example to feed latency histograms and error rate counters. The given path
+// is the full request path, including any resource IDs.
+type Metrics interface {
+	// ObserveRequest is called after each request (including all of its
+	// retries) has completed. The status is 0 when no response was received.
+	// Requests that are sent without retries, like Ping, configuration
+	// uploads and the log polling of a LogReader, are observed as well.
+	ObserveRequest(method, path string, status int, dur time.Duration)
+
+	// ObserveRetry is called each time a request is retried.
+	ObserveRetry(path string)
+}
+
+// noopMetrics implements Metrics without doing anything.
+type noopMetrics struct{}
+
+func (noopMetrics) ObserveRequest(method, path string, status int, dur time.Duration) {}
+func (noopMetrics) ObserveRetry(path string)                                          {}
+
+// Decoder decodes JSON:API documents into the structs of this package.
+type Decoder interface {
+	// UnmarshalPayload decodes a document containing a single resource into
+	// model, which is a pointer to a struct.
+	UnmarshalPayload(r io.Reader, model interface{}) error
+
+	// UnmarshalManyPayload decodes a document containing a list of resources
+	// into a list of values of type t, which is a pointer to a struct.
+	UnmarshalManyPayload(r io.Reader, t reflect.Type) ([]interface{}, error)
+}
+
+// jsonapiDecoder implements Decoder using the jsonapi package.
+type jsonapiDecoder struct{}
+
+func (jsonapiDecoder) UnmarshalPayload(r io.Reader, model interface{}) error {
+	return jsonapi.UnmarshalPayload(r, model)
+}
+
+func (jsonapiDecoder) UnmarshalManyPayload(r io.Reader, t reflect.Type) ([]interface{}, error) {
+	return jsonapi.UnmarshalManyPayload(r, t)
 }
 
 // DefaultConfig returns a default config structure.
@@ -82,6 +304,12 @@ func DefaultConfig() *Config {
 		Token:      os.Getenv("TFE_TOKEN"),
 		Headers:    make(http.Header),
 		HTTPClient: cleanhttp.DefaultPooledClient(),
+		Metrics:    noopMetrics{},
+		Decoder:    jsonapiDecoder{},
+
+		RetryMax:            30,
+		RetryServerErrorMax: 3,
+		RetryBaseDelay:      700 * time.Millisecond,
 	}
 
 	// Set the default address if none is given.
@@ -98,15 +326,31 @@ func DefaultConfig() *Config {
 // Client is the Terraform Enterprise API client. It provides the basic
 // connectivity and configuration for accessing the TFE API.
 type Client struct {
-	baseURL           *url.URL
-	token             string
-	headers           http.Header
-	http              *retryablehttp.Client
-	limiter           *rate.Limiter
-	retryLogHook      RetryLogHook
-	retryServerErrors bool
-
+	baseURL             *url.URL
+	token               string
+	headers             http.Header
+	decoder             Decoder
+	entitlements        *entitlementsCache
+	metrics             Metrics
+	http                *retryablehttp.Client
+	httpClient          *http.Client
+	limiter             *rate.Limiter
+	retryBaseDelay      time.Duration
+	retryLogHook        RetryLogHook
+	retryServerErrors   bool
+	retryServerErrorMax int
+	warningHook         WarningHook
+
+	// DefaultOrganization is used by the methods that take an organization
+	// when they are called with an empty organization name.
+	DefaultOrganization string
+
+	AdminTerraformVersions     AdminTerraformVersions
+	Agents                     Agents
+	AgentPools                 AgentPools
+	AgentTokens                AgentTokens
 	Applies                    Applies
+	AssessmentResults          AssessmentResults
 	ConfigurationVersions      ConfigurationVersions
 	CostEstimates              CostEstimates
 	NotificationConfigurations NotificationConfigurations
@@ -119,17 +363,25 @@ type Client struct {
 	PlanExports                PlanExports
 	Policies                   Policies
 	PolicyChecks               PolicyChecks
+	PolicyEvaluations          PolicyEvaluations
 	PolicySetParameters        PolicySetParameters
 	PolicySets                 PolicySets
+	Projects                   Projects
+	RegistryModules            RegistryModules
 	Runs                       Runs
+	RunEvents                  RunEvents
+	RunTasks                   RunTasks
+	RunTriggers                RunTriggers
 	SSHKeys                    SSHKeys
 	StateVersions              StateVersions
+	StateVersionOutputs        StateVersionOutputs
 	Teams                      Teams
 	TeamAccess                 TeamAccesses
 	TeamMembers                TeamMembers
 	TeamTokens                 TeamTokens
 	Users                      Users
 	Variables                  Variables
+	WorkspaceRunTasks          WorkspaceRunTasks
 	Workspaces                 Workspaces
 }
 
@@ -157,6 +409,30 @@ func NewClient(cfg *Config) (*Client, error) {
 		if cfg.RetryLogHook != nil {
 			config.RetryLogHook = cfg.RetryLogHook
 		}
+		if cfg.RetryMax != 0 {
+			config.RetryMax = cfg.RetryMax
+		}
+		if cfg.RetryServerErrorMax != 0 {
+			config.RetryServerErrorMax = cfg.RetryServerErrorMax
+		}
+		if cfg.RetryBaseDelay != 0 {
+			config.RetryBaseDelay = cfg.RetryBaseDelay
+		}
+		if cfg.WarningHook != nil {
+			config.WarningHook = cfg.WarningHook
+		}
+		if cfg.EntitlementsCacheTTL != 0 {
+			config.EntitlementsCacheTTL = cfg.EntitlementsCacheTTL
+		}
+		if cfg.Metrics != nil {
+			config.Metrics = cfg.Metrics
+		}
+		if cfg.Decoder != nil {
+			config.Decoder = cfg.Decoder
+		}
+		if cfg.DefaultOrganization != "" {
+			config.DefaultOrganization = cfg.DefaultOrganization
+		}
 	}
 
 	// Parse the address to make sure its a valid URL.
@@ -177,21 +453,24 @@ func NewClient(cfg *Config) (*Client, error) {
 
 	// Create the client.
 	client := &Client{
-		baseURL:      baseURL,
-		token:        config.Token,
-		headers:      config.Headers,
-		retryLogHook: config.RetryLogHook,
+		baseURL:             baseURL,
+		token:               config.Token,
+		headers:             config.Headers,
+		decoder:             config.Decoder,
+		entitlements:        newEntitlementsCache(config.EntitlementsCacheTTL),
+		metrics:             config.Metrics,
+		httpClient:          config.HTTPClient,
+		retryBaseDelay:      config.RetryBaseDelay,
+		retryLogHook:        config.RetryLogHook,
+		retryServerErrors:   true,
+		retryServerErrorMax: config.RetryServerErrorMax,
+		warningHook:         config.WarningHook,
+
+		DefaultOrganization: config.DefaultOrganization,
 	}
 
-	client.http = &retryablehttp.Client{
-		Backoff:      client.retryHTTPBackoff,
-		CheckRetry:   client.retryHTTPCheck,
-		ErrorHandler: retryablehttp.PassthroughErrorHandler,
-		HTTPClient:   config.HTTPClient,
-		RetryWaitMin: 100 * time.Millisecond,
-		RetryWaitMax: 400 * time.Millisecond,
-		RetryMax:     30,
-	}
+	// Create the retryable HTTP client.
+	client.http = client.newRetryableClient(config.RetryMax)
 
 	// Configure the rate limiter.
 	if err := client.configureLimiter(); err != nil {
@@ -199,56 +478,327 @@ func NewClient(cfg *Config) (*Client, error) {
 	}
 
 	// Create the services.
-	client.Applies = &applies{client: client}
-	client.ConfigurationVersions = &configurationVersions{client: client}
-	client.CostEstimates = &costEstimates{client: client}
-	client.NotificationConfigurations = &notificationConfigurations{client: client}
-	client.OAuthClients = &oAuthClients{client: client}
-	client.OAuthTokens = &oAuthTokens{client: client}
-	client.Organizations = &organizations{client: client}
-	client.OrganizationMemberships = &organizationMemberships{client: client}
-	client.OrganizationTokens = &organizationTokens{client: client}
-	client.Plans = &plans{client: client}
-	client.PlanExports = &planExports{client: client}
-	client.Policies = &policies{client: client}
-	client.PolicyChecks = &policyChecks{client: client}
-	client.PolicySetParameters = &policySetParameters{client: client}
-	client.PolicySets = &policySets{client: client}
-	client.Runs = &runs{client: client}
-	client.SSHKeys = &sshKeys{client: client}
-	client.StateVersions = &stateVersions{client: client}
-	client.Teams = &teams{client: client}
-	client.TeamAccess = &teamAccesses{client: client}
-	client.TeamMembers = &teamMembers{client: client}
-	client.TeamTokens = &teamTokens{client: client}
-	client.Users = &users{client: client}
-	client.Variables = &variables{client: client}
-	client.Workspaces = &workspaces{client: client}
+	client.createServices()
 
 	return client, nil
 }
 
+// newRetryableClient creates a retryable HTTP client of which the retry
+// policy, hooks and redirect policy are bound to c. It uses a copy of the
+// provided HTTP client, so the redirect policy can be set without changing
+// the provided client, while the transport and its connections are shared.
+func (c *Client) newRetryableClient(retryMax int) *retryablehttp.Client {
+	httpClient := *c.httpClient
+	httpClient.CheckRedirect = c.checkRedirect(c.httpClient.CheckRedirect)
+
+	return &retryablehttp.Client{
+		Backoff:      noBackoff,
+		CheckRetry:   c.checkRetry,
+		ErrorHandler: retryablehttp.PassthroughErrorHandler,
+		HTTPClient:   &httpClient,
+		RequestLogHook: func(_ retryablehttp.Logger, req *http.Request, attempt int) {
+			if state, ok := req.Context().Value(retryStateKey{}).(*retryState); ok {
+				state.attempt = attempt
+			}
+			if attempt > 0 {
+				c.metrics.ObserveRetry(req.URL.Path)
+			}
+		},
+		RetryWaitMin: 100 * time.Millisecond,
+		RetryWaitMax: 400 * time.Millisecond,
+		RetryMax:     retryMax,
+	}
+}
+
+// createServices creates the services of the client.
+func (c *Client) createServices() {
+	c.AdminTerraformVersions = &adminTerraformVersions{client: c}
+	c.Agents = &agents{client: c}
+	c.AgentPools = &agentPools{client: c}
+	c.AgentTokens = &agentTokens{client: c}
+	c.Applies = &applies{client: c}
+	c.AssessmentResults = &assessmentResults{client: c}
+	c.ConfigurationVersions = &configurationVersions{client: c}
+	c.CostEstimates = &costEstimates{client: c}
+	c.NotificationConfigurations = &notificationConfigurations{client: c}
+	c.OAuthClients = &oAuthClients{client: c}
+	c.OAuthTokens = &oAuthTokens{client: c}
+	c.Organizations = &organizations{client: c}
+	c.OrganizationMemberships = &organizationMemberships{client: c}
+	c.OrganizationTokens = &organizationTokens{client: c}
+	c.Plans = &plans{client: c}
+	c.PlanExports = &planExports{client: c}
+	c.Policies = &policies{client: c}
+	c.PolicyChecks = &policyChecks{client: c}
+	c.PolicyEvaluations = &policyEvaluations{client: c}
+	c.PolicySetParameters = &policySetParameters{client: c}
+	c.PolicySets = &policySets{client: c}
+	c.Projects = &projects{client: c}
+	c.RegistryModules = &registryModules{client: c}
+	c.Runs = &runs{client: c}
+	c.RunEvents = &runEvents{client: c}
+	c.RunTasks = &runTasks{client: c}
+	c.RunTriggers = &runTriggers{client: c}
+	c.SSHKeys = &sshKeys{client: c}
+	c.StateVersions = &stateVersions{client: c}
+	c.StateVersionOutputs = &stateVersionOutputs{client: c}
+	c.Teams = &teams{client: c}
+	c.TeamAccess = &teamAccesses{client: c}
+	c.TeamMembers = &teamMembers{client: c}
+	c.TeamTokens = &teamTokens{client: c}
+	c.Users = &users{client: c}
+	c.Variables = &variables{client: c}
+	c.WorkspaceRunTasks = &workspaceRunTasks{client: c}
+	c.Workspaces = &workspaces{client: c}
+}
+
+// Clone returns a copy of the client. The copy shares the transport of the
+// HTTP client, the rate limiter and the entitlements cache with the original
+// client, so it is cheap to create, for example for every request a service
+// handles. Changing the retry policy or hooks of the copy doesn't affect the
+// original client.
+func (c *Client) Clone() *Client {
+	clone := *c
+	clone.headers = c.headers.Clone()
+	clone.http = clone.newRetryableClient(c.http.RetryMax)
+	clone.createServices()
+	return &clone
+}
+
+// WithToken returns a copy of the client that uses the given API token, for
+// example to act on behalf of another user. The copy gets its own, empty
+// entitlements cache, as the entitlements depend on the token.
+func (c *Client) WithToken(token string) *Client {
+	clone := c.Clone()
+	clone.token = token
+	clone.entitlements = newEntitlementsCache(c.entitlements.ttl)
+	return clone
+}
+
+// WithOrganization returns a copy of the client that uses the given
+// organization as its default organization.
+func (c *Client) WithOrganization(organization string) *Client {
+	clone := c.Clone()
+	clone.DefaultOrganization = organization
+	return clone
+}
+
+// Ping verifies that the API can be reached and that the token of the client
+// is valid, using a single request without any retries. A network error is
+// returned wrapped in ErrUnreachable and a server error wrapped in
+// ErrServiceUnavailable. An invalid token results in ErrUnauthorized and a
+// token that isn't allowed to read the account details in
+// ErrInsufficientPermissions.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := c.newRequest("GET", "account/details", nil)
+	if err != nil {
+		return err
+	}
+
+	// Wait will block until the limiter can obtain a new token
+	// or returns an error if the given context is canceled.
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	resp, err := c.doOnce(req.Request.WithContext(ctx))
+	if err != nil {
+		// If we got an error, and the context has been canceled,
+		// the context's error is probably more useful.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			return fmt.Errorf("%w: %v", ErrUnreachable, err)
+		}
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == 403:
+		return ErrInsufficientPermissions
+	case resp.StatusCode >= 500:
+		return fmt.Errorf("%w: %s", ErrServiceUnavailable, resp.Status)
+	}
+
+	return checkResponseCode(resp)
+}
+
+// doOnce sends a single request without any retries, for the requests that
+// can't be sent with do, and reports it to the metrics like do does.
+func (c *Client) doOnce(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := c.http.HTTPClient.Do(req)
+
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	c.metrics.ObserveRequest(req.Method, req.URL.Path, status, time.Since(start))
+
+	return resp, err
+}
+
+// download retrieves the data behind a pre-signed download URL, as returned
+// by downloadURL. The URL expires after some time, so when the download is
+// refused with a 403, downloadURL is called again for a fresh URL and
+// the download is retried. It is only retried once to prevent looping on real
+// 403s.
+func (c *Client) download(ctx context.Context, accept string, downloadURL func() (string, error)) ([]byte, error) {
+	for retried := false; ; retried = true {
+		u, err := downloadURL()
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := c.newRequest("GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", accept)
+
+		var buf bytes.Buffer
+		err = c.do(ctx, req, &buf)
+
+		var errResp *ErrorResponse
+		if !retried && errors.As(err, &errResp) && errResp.StatusCode == 403 {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+}
+
+// organization returns the given organization name, or the default
+// organization of the client when the given name is empty.
+func (c *Client) organization(organization string) (string, error) {
+	if organization == "" {
+		organization = c.DefaultOrganization
+	}
+	if organization == "" {
+		return "", errors.New("organization is required")
+	}
+	if !validStringID(&organization) {
+		return "", errors.New("invalid value for organization")
+	}
+	return organization, nil
+}
+
+// InvalidateEntitlements removes the cached entitlements of the given
+// organization, forcing the next call to Organizations.Entitlements to
+// fetch them from the API. Use this after the plan of an organization
+// changed.
+func (c *Client) InvalidateEntitlements(organization string) {
+	c.entitlements.invalidate(organization)
+}
+
+// SetRetryLogHook sets the hook that is called each time a request is
+// retried, replacing the one from the config.
+func (c *Client) SetRetryLogHook(hook RetryLogHook) {
+	c.retryLogHook = hook
+}
+
 // RetryServerErrors configures the retry HTTP check to also retry
-// unexpected errors or requests that failed with a server error.
+// idempotent requests that failed with a connection or server error. This is
+// enabled by default, up to RetryServerErrorMax retries, so pass false to only
+// retry rate limited requests.
 func (c *Client) RetryServerErrors(retry bool) {
 	c.retryServerErrors = retry
 }
 
+// retryState keeps track of the attempts of a single request, so the
+// backoff can be determined when checking if the request should be retried.
+type retryState struct {
+	method       string
+	attempt      int
+	serverErrors int
+}
+
+// retryStateKey is the context key of the retry state of a request.
+type retryStateKey struct{}
+
+// noBackoff provides a callback for Client.Backoff which doesn't wait at
+// all. The backoff is waited for in checkRetry instead, as the retryable
+// HTTP client can't interrupt its own wait when the context is canceled.
+func noBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	return 0
+}
+
+// checkRetry provides a callback for Client.CheckRetry. It uses
+// retryHTTPCheck to decide if the request should be retried and then waits
+// for the backoff, returning the context error as soon as the context of
+// the request is canceled.
+func (c *Client) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	retry, checkErr := c.retryHTTPCheck(ctx, resp, err)
+	if !retry {
+		return false, checkErr
+	}
+
+	attempt := 0
+	if state, ok := ctx.Value(retryStateKey{}).(*retryState); ok {
+		attempt = state.attempt
+
+		// Only rate limited requests are retried up to RetryMax.
+		if resp == nil || resp.StatusCode != 429 {
+			state.serverErrors++
+			if state.serverErrors > c.retryServerErrorMax {
+				return false, checkErr
+			}
+		}
+	}
+
+	// There is no need to wait when the request won't be retried anymore.
+	if attempt >= c.http.RetryMax {
+		return true, checkErr
+	}
+
+	timer := time.NewTimer(c.retryHTTPBackoff(c.http.RetryWaitMin, c.http.RetryWaitMax, attempt, resp))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-timer.C:
+		return true, checkErr
+	}
+}
+
 // retryHTTPCheck provides a callback for Client.CheckRetry which
-// will retry both rate limit (429) and server (>= 500) errors.
+// will retry rate limit (429), connection and server (>= 500) errors.
+// Connection and server errors are only retried for idempotent requests,
+// as a POST or PATCH may have been processed even though the server failed
+// to respond.
 func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
 	if ctx.Err() != nil {
 		return false, ctx.Err()
 	}
 	if err != nil {
-		return c.retryServerErrors, err
+		// Without a response, the method is taken from the retry state.
+		state, ok := ctx.Value(retryStateKey{}).(*retryState)
+		return c.retryServerErrors && (!ok || isIdempotent(state.method)), err
 	}
-	if resp.StatusCode == 429 || (c.retryServerErrors && resp.StatusCode >= 500) {
+	if resp.StatusCode == 429 {
 		return true, nil
 	}
+	if c.retryServerErrors && resp.StatusCode >= 500 {
+		return resp.Request == nil || isIdempotent(resp.Request.Method), nil
+	}
 	return false, nil
 }
 
+// isIdempotent reports whether requests with the given method can safely
+// be sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case "DELETE", "GET", "HEAD", "OPTIONS", "PUT":
+		return true
+	}
+	return false
+}
+
 // retryHTTPBackoff provides a generic callback for Client.Backoff which
 // will pass through all calls based on the status code of the response.
 func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
@@ -261,16 +811,62 @@ func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *
 		return rateLimitBackoff(min, max, attemptNum, resp)
 	}
 
-	// Set custom duration's when we experience a service interruption.
-	min = 700 * time.Millisecond
-	max = 900 * time.Millisecond
+	// Honor the time to wait the server asked for, if any.
+	if wait := retryAfter(resp); wait > 0 {
+		return wait
+	}
+
+	return exponentialJitterBackoff(c.retryBaseDelay, 30*time.Second, attemptNum)
+}
+
+// exponentialJitterBackoff doubles the base duration for every attempt,
+// limited by max, and adds up to 25 percent of jitter to prevent a
+// thundering herd.
+func exponentialJitterBackoff(base, max time.Duration, attemptNum int) time.Duration {
+	wait := max
+	if attemptNum < 32 {
+		if backoff := base << uint(attemptNum); backoff > 0 && backoff < max {
+			wait = backoff
+		}
+	}
 
-	return retryablehttp.LinearJitterBackoff(min, max, attemptNum, resp)
+	// rnd is used to generate pseudo-random numbers.
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	return wait + time.Duration(rnd.Float64()*float64(wait)/4)
+}
+
+// retryAfter returns the time to wait given in the Retry-After header of
+// the response, which is either a number of seconds or an HTTP date. It
+// returns 0 when the header is missing or invalid.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	v := resp.Header.Get(headerRetryAfter)
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		return 0
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if wait := time.Until(t); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
 }
 
 // rateLimitBackoff provides a callback for Client.Backoff which will use the
-// X-RateLimit_Reset header to determine the time to wait. We add some jitter
-// to prevent a thundering herd.
+// X-RateLimit_Reset header, or the Retry-After header when it is missing, to
+// determine the time to wait. We add some jitter to prevent a thundering
+// herd.
 //
 // min and max are mainly used for bounding the jitter that will be added to
 // the reset time retrieved from the headers. But if the final wait time is
@@ -290,12 +886,40 @@ func rateLimitBackoff(min, max time.Duration, attemptNum int, resp *http.Respons
 					min = wait
 				}
 			}
+		} else if wait := retryAfter(resp); wait > min {
+			min = wait
 		}
 	}
 
 	return min + jitter
 }
 
+// isAPIHost reports whether the URL points to the host of the API.
+func (c *Client) isAPIHost(u *url.URL) bool {
+	return u.Scheme == c.baseURL.Scheme && strings.EqualFold(u.Host, c.baseURL.Host)
+}
+
+// checkRedirect returns the redirect policy of the HTTP client. Artifact
+// endpoints, like the ones to download a state or a plan export, redirect
+// to a blob store. The Authorization header is removed before following a
+// redirect to any other host than the API, as the token should never be sent
+// to a third party. Unlike the default policy of the http package, this also
+// applies to subdomains and other ports of the API host.
+func (c *Client) checkRedirect(next func(*http.Request, []*http.Request) error) func(*http.Request, []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if !c.isAPIHost(req.URL) {
+			req.Header.Del("Authorization")
+		}
+		if next != nil {
+			return next(req, via)
+		}
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+}
+
 // configureLimiter configures the rate limiter.
 func (c *Client) configureLimiter() error {
 	// Create a new request.
@@ -316,7 +940,7 @@ func (c *Client) configureLimiter() error {
 	req.Header.Set("Authorization", "Bearer "+c.token)
 
 	// Make a single request to retrieve the rate limit headers.
-	resp, err := c.http.HTTPClient.Do(req)
+	resp, err := c.doOnce(req)
 	if err != nil {
 		return err
 	}
@@ -357,9 +981,12 @@ func (c *Client) newRequest(method, path string, v interface{}) (*retryablehttp.
 		return nil, err
 	}
 
-	// Create a request specific headers map.
+	// Create a request specific headers map. The token is only sent to the
+	// API itself, and never to another host given as an absolute URL.
 	reqHeaders := make(http.Header)
-	reqHeaders.Set("Authorization", "Bearer "+c.token)
+	if c.isAPIHost(u) {
+		reqHeaders.Set("Authorization", "Bearer "+c.token)
+	}
 
 	var body interface{}
 	switch method {
@@ -379,7 +1006,10 @@ func (c *Client) newRequest(method, path string, v interface{}) (*retryablehttp.
 
 		if v != nil {
 			buf := bytes.NewBuffer(nil)
-			if err := jsonapi.MarshalPayloadWithoutIncluded(buf, v); err != nil {
+			if raw, ok := v.(json.RawMessage); ok {
+				// An already encoded payload is sent as is.
+				buf.Write(raw)
+			} else if err := jsonapi.MarshalPayloadWithoutIncluded(buf, v); err != nil {
 				return nil, err
 			}
 			body = buf
@@ -424,12 +1054,26 @@ func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface
 		return err
 	}
 
-	// Add the context to the request.
-	req = req.WithContext(ctx)
+	// Add the context to the request, together with the state used
+	// to keep track of its retries.
+	req = req.WithContext(context.WithValue(ctx, retryStateKey{}, &retryState{method: req.Method}))
 
 	// Execute the request and check the response.
+	start := time.Now()
 	resp, err := c.http.Do(req)
+
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	c.metrics.ObserveRequest(req.Method, req.URL.Path, status, time.Since(start))
+
 	if err != nil {
+		// A retry can be interrupted after a response was received.
+		if resp != nil {
+			resp.Body.Close()
+		}
+
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
 		select {
@@ -441,13 +1085,22 @@ func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface
 	}
 	defer resp.Body.Close()
 
+	// Surface any deprecation warnings sent in the headers.
+	c.reportHeaderWarnings(req, resp)
+
 	// Basic response checking.
 	if err := checkResponseCode(resp); err != nil {
 		return err
 	}
 
-	// Return here if decoding the response isn't needed.
+	// Return here if decoding the response isn't needed. The request
+	// succeeded, so failing to read the body for warnings is not an error.
 	if v == nil {
+		if c.warningHook != nil {
+			if body, err := ioutil.ReadAll(resp.Body); err == nil {
+				c.reportBodyWarnings(req, body)
+			}
+		}
 		return nil
 	}
 
@@ -472,7 +1125,20 @@ func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface
 	// Unmarshal a single value if v does not contain the
 	// Items and Pagination struct fields.
 	if !items.IsValid() || !pagination.IsValid() {
-		return jsonapi.UnmarshalPayload(resp.Body, v)
+		if !needsRawDecode(dst.Type()) && c.warningHook == nil {
+			return c.decoder.UnmarshalPayload(resp.Body, v)
+		}
+
+		// Keep a copy of the body to decode the raw resource from.
+		body := bytes.NewBuffer(nil)
+		if err := c.decoder.UnmarshalPayload(io.TeeReader(resp.Body, body), v); err != nil {
+			return err
+		}
+		c.reportBodyWarnings(req, body.Bytes())
+		if !needsRawDecode(dst.Type()) {
+			return nil
+		}
+		return decodeRawResource(body.Bytes(), v)
 	}
 
 	// Return an error if v.Items is not a slice.
@@ -485,7 +1151,7 @@ func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface
 	reader := io.TeeReader(resp.Body, body)
 
 	// Unmarshal as a list of values as v.Items is a slice.
-	raw, err := jsonapi.UnmarshalManyPayload(reader, items.Type().Elem())
+	raw, err := c.decoder.UnmarshalManyPayload(reader, items.Type().Elem())
 	if err != nil {
 		return err
 	}
@@ -502,6 +1168,16 @@ func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface
 	// Pointer-swap the result.
 	items.Set(result)
 
+	// Surface any deprecation warnings sent in the body.
+	c.reportBodyWarnings(req, body.Bytes())
+
+	// Decode the parts of the items the decoder discards, if needed.
+	if needsRawDecode(items.Type().Elem()) {
+		if err := decodeManyRawResources(body.Bytes(), items); err != nil {
+			return err
+		}
+	}
+
 	// As we are getting a list of values, we need to decode
 	// the pagination details out of the response body.
 	p, err := parsePagination(body)
@@ -549,6 +1225,80 @@ func parsePagination(body io.Reader) (*Pagination, error) {
 	return &raw.Meta.Pagination, nil
 }
 
+// maxPages is the maximum number of pages listPages requests, so a server
+// that keeps returning a next page can't make it loop forever.
+const maxPages = 1000
+
+// listPages calls list for every page, starting at the given page number,
+// until the returned pagination has no next page or list reports that it is
+// done. A next page that isn't after the current page also ends the listing.
+func listPages(pageNumber int, list func(pageNumber int) (*Pagination, bool, error)) error {
+	for i := 0; i < maxPages; i++ {
+		p, done, err := list(pageNumber)
+		if err != nil || done {
+			return err
+		}
+
+		if p == nil || p.NextPage == 0 || p.NextPage <= p.CurrentPage {
+			return nil
+		}
+		pageNumber = p.NextPage
+	}
+	return fmt.Errorf("stopped listing after %d pages", maxPages)
+}
+
+// reportHeaderWarnings passes the deprecation warnings found in the
+// X-TFE-Deprecation headers of the response to the warning hook.
+func (c *Client) reportHeaderWarnings(req *retryablehttp.Request, resp *http.Response) {
+	if c.warningHook == nil {
+		return
+	}
+	for _, warning := range resp.Header.Values("X-TFE-Deprecation") {
+		if warning = strings.TrimSpace(warning); warning != "" {
+			c.warningHook(req.Method, req.URL.Path, warning)
+		}
+	}
+}
+
+// reportBodyWarnings passes the warnings found in the meta.warnings block of
+// the response body to the warning hook. Warnings can either be plain strings
+// or objects with a detail or title. A body that can't be decoded is ignored,
+// as warnings should never cause an otherwise successful request to fail.
+func (c *Client) reportBodyWarnings(req *retryablehttp.Request, body []byte) {
+	if c.warningHook == nil || len(bytes.TrimSpace(body)) == 0 {
+		return
+	}
+
+	var raw struct {
+		Meta struct {
+			Warnings []json.RawMessage `json:"warnings"`
+		} `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return
+	}
+
+	for _, w := range raw.Meta.Warnings {
+		var warning string
+		if err := json.Unmarshal(w, &warning); err != nil {
+			var obj struct {
+				Title  string `json:"title"`
+				Detail string `json:"detail"`
+			}
+			if err := json.Unmarshal(w, &obj); err != nil {
+				continue
+			}
+			warning = obj.Detail
+			if warning == "" {
+				warning = obj.Title
+			}
+		}
+		if warning = strings.TrimSpace(warning); warning != "" {
+			c.warningHook(req.Method, req.URL.Path, warning)
+		}
+	}
+}
+
 // checkResponseCode can be used to check the status code of an HTTP request.
 func checkResponseCode(r *http.Response) error {
 	if r.StatusCode >= 200 && r.StatusCode <= 299 {
@@ -558,6 +1308,13 @@ func checkResponseCode(r *http.Response) error {
 	switch r.StatusCode {
 	case 401:
 		return ErrUnauthorized
+	case 403:
+		switch {
+		case strings.HasSuffix(r.Request.URL.Path, "actions/lock"),
+			strings.HasSuffix(r.Request.URL.Path, "actions/unlock"),
+			strings.HasSuffix(r.Request.URL.Path, "actions/force-unlock"):
+			return ErrInsufficientPermissions
+		}
 	case 404:
 		return ErrResourceNotFound
 	case 409:
@@ -568,6 +1325,11 @@ func checkResponseCode(r *http.Response) error {
 			return ErrWorkspaceNotLocked
 		case strings.HasSuffix(r.Request.URL.Path, "actions/force-unlock"):
 			return ErrWorkspaceNotLocked
+		case isRunActionPath(r.Request.URL.Path):
+			return ErrRunActionNotAllowed
+		case strings.Contains(r.Request.URL.Path, "/policy-checks/") &&
+			strings.HasSuffix(r.Request.URL.Path, "/actions/override"):
+			return ErrPolicyCheckNotOverridable
 		}
 	}
 
@@ -575,18 +1337,76 @@ func checkResponseCode(r *http.Response) error {
 	errPayload := &jsonapi.ErrorsPayload{}
 	err := json.NewDecoder(r.Body).Decode(errPayload)
 	if err != nil || len(errPayload.Errors) == 0 {
-		return fmt.Errorf(r.Status)
+		if r.StatusCode == 409 {
+			return ErrConflict
+		}
+		return &ErrorResponse{StatusCode: r.StatusCode, Status: r.Status}
 	}
 
-	// Parse and format the errors.
-	var errs []string
-	for _, e := range errPayload.Errors {
-		if e.Detail == "" {
-			errs = append(errs, e.Title)
-		} else {
-			errs = append(errs, fmt.Sprintf("%s\n\n%s", e.Title, e.Detail))
+	// Check if the request failed because of a name collision.
+	if r.StatusCode == 422 {
+		for _, e := range errPayload.Errors {
+			if isAlreadyTakenError(e) {
+				return ErrResourceAlreadyExists
+			}
+		}
+	}
+
+	// Check if a cost estimate request failed because cost estimation is
+	// not enabled for the organization.
+	if (r.StatusCode == 403 || r.StatusCode == 422) && isCostEstimatePath(r.Request.URL.Path) {
+		for _, e := range errPayload.Errors {
+			if isFeatureNotEnabledError(e) {
+				return ErrFeatureNotEnabled
+			}
 		}
 	}
 
-	return fmt.Errorf(strings.Join(errs, "\n"))
+	return &ErrorResponse{
+		StatusCode: r.StatusCode,
+		Status:     r.Status,
+		Errors:     errPayload.Errors,
+	}
+}
+
+// isRunActionPath reports whether the path is the path of a run action.
+func isRunActionPath(path string) bool {
+	if !strings.Contains(path, "/runs/") {
+		return false
+	}
+	for _, action := range []string{"apply", "cancel", "discard", "force-cancel", "force-execute"} {
+		if strings.HasSuffix(path, "/actions/"+action) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCostEstimatePath reports whether the path is the path of a cost estimate.
+func isCostEstimatePath(path string) bool {
+	return strings.Contains(path, "/cost-estimates/")
+}
+
+// isAlreadyTakenError reports whether the error object describes a value
+// (usually the name) that is already taken by another resource.
+func isAlreadyTakenError(e *jsonapi.ErrorObject) bool {
+	for _, msg := range []string{e.Title, e.Detail} {
+		msg = strings.ToLower(msg)
+		if strings.Contains(msg, "already been taken") || strings.Contains(msg, "already exists") {
+			return true
+		}
+	}
+	return false
+}
+
+// isFeatureNotEnabledError reports whether the error object describes cost
+// estimation not being enabled for the organization.
+func isFeatureNotEnabledError(e *jsonapi.ErrorObject) bool {
+	for _, msg := range []string{e.Title, e.Detail} {
+		msg = strings.ToLower(msg)
+		if strings.Contains(msg, "not enabled") || strings.Contains(msg, "is disabled") {
+			return true
+		}
+	}
+	return false
 }
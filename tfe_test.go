@@ -3,9 +3,16 @@ package tfe
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -66,9 +73,12 @@ func TestClient_newClient(t *testing.T) {
 		if config.Token != client.token {
 			t.Fatalf("unexpected client token %q", client.token)
 		}
-		if ts.Client() != client.http.HTTPClient {
+		if ts.Client().Transport != client.http.HTTPClient.Transport {
 			t.Fatal("unexpected HTTP client value")
 		}
+		if client.http.HTTPClient.CheckRedirect == nil {
+			t.Fatal("expected the HTTP client to have a redirect policy")
+		}
 	})
 }
 
@@ -87,6 +97,9 @@ func TestClient_defaultConfig(t *testing.T) {
 		if config.HTTPClient == nil {
 			t.Fatalf("expected default http client, got %v", config.HTTPClient)
 		}
+		if config.RetryMax != 30 || config.RetryServerErrorMax != 3 {
+			t.Fatalf("expected 30 retries and 3 server error retries, got %d and %d", config.RetryMax, config.RetryServerErrorMax)
+		}
 	})
 
 	t.Run("with environment variables", func(t *testing.T) {
@@ -284,6 +297,7 @@ func TestClient_retryHTTPCheck(t *testing.T) {
 	cases := map[string]struct {
 		resp              *http.Response
 		err               error
+		method            string
 		retryServerErrors bool
 		checkOK           bool
 		checkErr          error
@@ -314,6 +328,26 @@ func TestClient_retryHTTPCheck(t *testing.T) {
 			checkOK:           true,
 			checkErr:          nil,
 		},
+		"503-get-with-server-errors": {
+			resp:              &http.Response{StatusCode: 503, Request: &http.Request{Method: "GET"}},
+			err:               nil,
+			retryServerErrors: true,
+			checkOK:           true,
+			checkErr:          nil,
+		},
+		"500-post-with-server-errors": {
+			resp:              &http.Response{StatusCode: 500, Request: &http.Request{Method: "POST"}},
+			err:               nil,
+			retryServerErrors: true,
+			checkOK:           false,
+			checkErr:          nil,
+		},
+		"429-post-no-server-errors": {
+			resp:     &http.Response{StatusCode: 429, Request: &http.Request{Method: "POST"}},
+			err:      nil,
+			checkOK:  true,
+			checkErr: nil,
+		},
 		"err-no-server-errors": {
 			err:      connErr,
 			checkOK:  false,
@@ -325,10 +359,22 @@ func TestClient_retryHTTPCheck(t *testing.T) {
 			checkOK:           true,
 			checkErr:          connErr,
 		},
+		"err-get-with-server-errors": {
+			err:               connErr,
+			method:            "GET",
+			retryServerErrors: true,
+			checkOK:           true,
+			checkErr:          connErr,
+		},
+		"err-post-with-server-errors": {
+			err:               connErr,
+			method:            "POST",
+			retryServerErrors: true,
+			checkOK:           false,
+			checkErr:          connErr,
+		},
 	}
 
-	ctx := context.Background()
-
 	for name, tc := range cases {
 		client, err := NewClient(cfg)
 		if err != nil {
@@ -337,6 +383,11 @@ func TestClient_retryHTTPCheck(t *testing.T) {
 
 		client.RetryServerErrors(tc.retryServerErrors)
 
+		ctx := context.Background()
+		if tc.method != "" {
+			ctx = context.WithValue(ctx, retryStateKey{}, &retryState{method: tc.method})
+		}
+
 		checkOK, checkErr := client.retryHTTPCheck(ctx, tc.resp, tc.err)
 		if checkOK != tc.checkOK {
 			t.Fatalf("test %s expected checkOK %t, got: %t", name, tc.checkOK, checkOK)
@@ -345,6 +396,18 @@ func TestClient_retryHTTPCheck(t *testing.T) {
 			t.Fatalf("test %s expected checkErr %v, got: %v", name, tc.checkErr, checkErr)
 		}
 	}
+
+	t.Run("by default", func(t *testing.T) {
+		client, err := NewClient(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp := &http.Response{StatusCode: 503, Request: &http.Request{Method: "GET"}}
+		if checkOK, _ := client.retryHTTPCheck(context.Background(), resp, nil); !checkOK {
+			t.Fatal("expected server errors to be retried by default")
+		}
+	})
 }
 
 func TestClient_retryHTTPBackoff(t *testing.T) {
@@ -384,6 +447,1273 @@ func TestClient_retryHTTPBackoff(t *testing.T) {
 	}
 }
 
+func TestClient_retryAfter(t *testing.T) {
+	newResponse := func(retryAfter string) *http.Response {
+		resp := &http.Response{StatusCode: 429, Header: make(http.Header)}
+		if retryAfter != "" {
+			resp.Header.Set("Retry-After", retryAfter)
+		}
+		return resp
+	}
+
+	cases := map[string]struct {
+		resp *http.Response
+		min  time.Duration
+		max  time.Duration
+	}{
+		"seconds": {
+			resp: newResponse("3"),
+			min:  3 * time.Second,
+			max:  3 * time.Second,
+		},
+		"http-date": {
+			resp: newResponse(time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)),
+			min:  8 * time.Second,
+			max:  10 * time.Second,
+		},
+		"date-in-the-past": {
+			resp: newResponse(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)),
+		},
+		"invalid": {
+			resp: newResponse("soon"),
+		},
+		"missing": {
+			resp: newResponse(""),
+		},
+		"no-response": {},
+	}
+
+	for name, tc := range cases {
+		wait := retryAfter(tc.resp)
+		if wait < tc.min || wait > tc.max {
+			t.Fatalf("test %s expected a wait between %s and %s, got: %s", name, tc.min, tc.max, wait)
+		}
+	}
+
+	// The rate limit backoff falls back to the Retry-After header.
+	if wait := rateLimitBackoff(100*time.Millisecond, 400*time.Millisecond, 0, newResponse("2")); wait < 2*time.Second {
+		t.Fatalf("expected to wait at least 2s, got: %s", wait)
+	}
+}
+
+func TestClient_retryConfig(t *testing.T) {
+	var bodies []string
+	var serverErrors int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/tfe/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/api/tfe/v2/organizations":
+			body, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			if len(bodies) == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.Header().Set(headerRateReset, "0.05")
+				w.WriteHeader(429)
+				return
+			}
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "acme", "type": "organizations"}}`))
+		case "/api/tfe/v2/organizations/broken":
+			atomic.AddInt32(&serverErrors, 1)
+			w.WriteHeader(502)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:        ts.URL,
+		Token:          "dummy-token",
+		HTTPClient:     ts.Client(),
+		RetryMax:       2,
+		RetryBaseDelay: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.RetryServerErrors(true)
+
+	t.Run("when a create is rate limited", func(t *testing.T) {
+		_, err := client.Organizations.Create(context.Background(), OrganizationCreateOptions{
+			Name:  String("acme"),
+			Email: String("info@example.com"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(bodies) != 2 {
+			t.Fatalf("expected 2 requests, got: %d", len(bodies))
+		}
+		if bodies[0] == "" || bodies[0] != bodies[1] {
+			t.Fatalf("expected the body to be sent again, got: %q", bodies)
+		}
+	})
+
+	t.Run("with a maximum number of retries", func(t *testing.T) {
+		_, err := client.Organizations.Read(context.Background(), "broken")
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if n := atomic.LoadInt32(&serverErrors); n != 3 {
+			t.Fatalf("expected 3 requests, got: %d", n)
+		}
+	})
+
+	t.Run("with a maximum number of server error retries", func(t *testing.T) {
+		atomic.StoreInt32(&serverErrors, 0)
+
+		client, err := NewClient(&Config{
+			Address:             ts.URL,
+			Token:               "dummy-token",
+			HTTPClient:          ts.Client(),
+			RetryMax:            5,
+			RetryServerErrorMax: 1,
+			RetryBaseDelay:      time.Millisecond,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.RetryServerErrors(true)
+
+		_, err = client.Organizations.Read(context.Background(), "broken")
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if n := atomic.LoadInt32(&serverErrors); n != 2 {
+			t.Fatalf("expected 2 requests, got: %d", n)
+		}
+	})
+}
+
+func TestClient_retryContextCancellation(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/tfe/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/api/tfe/v2/organizations/limited":
+			// Ask the client to back off for a long time.
+			w.Header().Set(headerRateReset, "30")
+			w.WriteHeader(429)
+		case "/api/tfe/v2/organizations/flaky":
+			if atomic.AddInt32(&requests, 1) == 1 {
+				w.Header().Set(headerRateReset, "0.05")
+				w.WriteHeader(429)
+				return
+			}
+			w.Write([]byte(`{"data": {"id": "flaky", "type": "organizations"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("when canceled during the backoff", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+
+		start := time.Now()
+		_, err := client.Organizations.Read(ctx, "limited")
+		if err != context.Canceled {
+			t.Fatalf("expected error %v, got: %v", context.Canceled, err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Fatalf("expected the backoff to be interrupted, returned after %s", elapsed)
+		}
+	})
+
+	t.Run("when the retry succeeds", func(t *testing.T) {
+		org, err := client.Organizations.Read(context.Background(), "flaky")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if org.Name != "flaky" {
+			t.Fatalf("expected organization flaky, got: %s", org.Name)
+		}
+		if n := atomic.LoadInt32(&requests); n != 2 {
+			t.Fatalf("expected 2 requests, got: %d", n)
+		}
+	})
+}
+
+func TestClient_requestDeadline(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/tfe/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+
+		// Simulate a hung instance that never responds.
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.Organizations.Read(ctx, "hung")
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected error %v, got: %v", context.DeadlineExceeded, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the request to respect the deadline, returned after %s", elapsed)
+	}
+}
+
+func TestClient_checkResponseCode(t *testing.T) {
+	newResponse := func(status int, path, body string) *http.Response {
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+			StatusCode: status,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    &http.Request{URL: &url.URL{Path: path}},
+		}
+	}
+
+	cases := map[string]struct {
+		resp *http.Response
+		err  error
+	}{
+		"200": {
+			resp: newResponse(200, "/api/tfe/v2/workspaces/ws-123", ""),
+			err:  nil,
+		},
+		"403-lock": {
+			resp: newResponse(403, "/api/tfe/v2/workspaces/ws-123/actions/lock", ""),
+			err:  ErrInsufficientPermissions,
+		},
+		"403-force-unlock": {
+			resp: newResponse(403, "/api/tfe/v2/workspaces/ws-123/actions/force-unlock", ""),
+			err:  ErrInsufficientPermissions,
+		},
+		"403-other": {
+			resp: newResponse(403, "/api/tfe/v2/workspaces/ws-123", ""),
+			err:  errors.New("403 Forbidden"),
+		},
+		"404": {
+			resp: newResponse(404, "/api/tfe/v2/workspaces/ws-123", ""),
+			err:  ErrResourceNotFound,
+		},
+		"422-name-taken": {
+			resp: newResponse(422, "/api/tfe/v2/organizations/foo/workspaces", `{"errors":[{"status":"422","title":"invalid attribute","detail":"Name has already been taken"}]}`),
+			err:  ErrResourceAlreadyExists,
+		},
+		"422-other": {
+			resp: newResponse(422, "/api/tfe/v2/organizations/foo/workspaces", `{"errors":[{"status":"422","title":"invalid attribute","detail":"Name is invalid"}]}`),
+			err:  errors.New("invalid attribute\n\nName is invalid"),
+		},
+		"403-feature-not-enabled": {
+			resp: newResponse(403, "/api/tfe/v2/cost-estimates/ce-123", `{"errors":[{"status":"403","title":"forbidden","detail":"Cost estimation is not enabled for this organization"}]}`),
+			err:  ErrFeatureNotEnabled,
+		},
+		"422-feature-not-enabled": {
+			resp: newResponse(422, "/api/tfe/v2/cost-estimates/ce-123", `{"errors":[{"status":"422","title":"Cost estimation is disabled"}]}`),
+			err:  ErrFeatureNotEnabled,
+		},
+		"422-disabled-other": {
+			resp: newResponse(422, "/api/tfe/v2/runs", `{"errors":[{"status":"422","title":"invalid attribute","detail":"Workspace is disabled"}]}`),
+			err:  errors.New("invalid attribute\n\nWorkspace is disabled"),
+		},
+		"409-lock": {
+			resp: newResponse(409, "/api/tfe/v2/workspaces/ws-123/actions/lock", ""),
+			err:  ErrWorkspaceLocked,
+		},
+		"409-run-apply": {
+			resp: newResponse(409, "/api/tfe/v2/runs/run-123/actions/apply", `{"errors":[{"status":"409","title":"transition not allowed"}]}`),
+			err:  ErrRunActionNotAllowed,
+		},
+		"409-run-force-cancel": {
+			resp: newResponse(409, "/api/tfe/v2/runs/run-123/actions/force-cancel", ""),
+			err:  ErrRunActionNotAllowed,
+		},
+		"409-other": {
+			resp: newResponse(409, "/api/tfe/v2/organizations/foo/workspaces", ""),
+			err:  ErrConflict,
+		},
+		"409-other-with-payload": {
+			resp: newResponse(409, "/api/tfe/v2/organizations/foo/workspaces", `{"errors":[{"status":"409","title":"conflict","detail":"The workspace is being modified"}]}`),
+			err:  errors.New("conflict\n\nThe workspace is being modified"),
+		},
+		"409-policy-check-override": {
+			resp: newResponse(409, "/api/tfe/v2/policy-checks/polchk-123/actions/override", `{"errors":[{"status":"409","title":"conflict"}]}`),
+			err:  ErrPolicyCheckNotOverridable,
+		},
+		"500-no-payload": {
+			resp: newResponse(500, "/api/tfe/v2/workspaces/ws-123", ""),
+			err:  errors.New("500 Internal Server Error"),
+		},
+	}
+
+	for name, tc := range cases {
+		err := checkResponseCode(tc.resp)
+		if tc.err == nil && err != nil {
+			t.Fatalf("test %s expected no error, got: %v", name, err)
+		}
+		if tc.err != nil && (err == nil || err.Error() != tc.err.Error()) {
+			t.Fatalf("test %s expected error %v, got: %v", name, tc.err, err)
+		}
+	}
+}
+
+type countingDecoder struct {
+	jsonapiDecoder
+	single int
+	many   int
+}
+
+func (d *countingDecoder) UnmarshalPayload(r io.Reader, model interface{}) error {
+	d.single++
+	return d.jsonapiDecoder.UnmarshalPayload(r, model)
+}
+
+func (d *countingDecoder) UnmarshalManyPayload(r io.Reader, t reflect.Type) ([]interface{}, error) {
+	d.many++
+	return d.jsonapiDecoder.UnmarshalManyPayload(r, t)
+}
+
+func TestClient_errorResponse(t *testing.T) {
+	newResponse := func(status int, body string) *http.Response {
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+			StatusCode: status,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    &http.Request{URL: &url.URL{Path: "/api/tfe/v2/organizations/foo/workspaces"}},
+		}
+	}
+
+	t.Run("with a conflict", func(t *testing.T) {
+		err := checkResponseCode(newResponse(409, `{"errors":[{"status":"409","title":"conflict","detail":"The workspace is being modified"}]}`))
+		if !errors.Is(err, ErrConflict) {
+			t.Fatalf("expected error to match %v, got: %v", ErrConflict, err)
+		}
+
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) {
+			t.Fatalf("expected an *ErrorResponse, got: %T", err)
+		}
+		if errResp.StatusCode != 409 {
+			t.Fatalf("expected status code 409, got: %d", errResp.StatusCode)
+		}
+		if len(errResp.Errors) != 1 || errResp.Errors[0].Detail != "The workspace is being modified" {
+			t.Fatalf("unexpected errors: %+v", errResp.Errors)
+		}
+	})
+
+	t.Run("with a workspace lock conflict", func(t *testing.T) {
+		resp := newResponse(409, "")
+		resp.Request.URL.Path = "/api/tfe/v2/workspaces/ws-123/actions/lock"
+
+		err := checkResponseCode(resp)
+		if err != ErrWorkspaceLocked {
+			t.Fatalf("expected error %v, got: %v", ErrWorkspaceLocked, err)
+		}
+		if !errors.Is(err, ErrConflict) {
+			t.Fatalf("expected error to match %v", ErrConflict)
+		}
+	})
+
+	t.Run("without an error payload", func(t *testing.T) {
+		err := checkResponseCode(newResponse(403, "Request has expired"))
+
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) {
+			t.Fatalf("expected an *ErrorResponse, got: %T", err)
+		}
+		if errResp.StatusCode != 403 || len(errResp.Errors) != 0 {
+			t.Fatalf("unexpected error response: %+v", errResp)
+		}
+		if err.Error() != "403 Forbidden" {
+			t.Fatalf("expected error %q, got: %q", "403 Forbidden", err.Error())
+		}
+	})
+
+	t.Run("with an invalid attribute", func(t *testing.T) {
+		err := checkResponseCode(newResponse(422, `{"errors":[{"status":"422","title":"invalid attribute","detail":"Name is invalid"},{"status":"422","title":"invalid attribute"}]}`))
+		if errors.Is(err, ErrConflict) {
+			t.Fatalf("expected error not to match %v", ErrConflict)
+		}
+
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) {
+			t.Fatalf("expected an *ErrorResponse, got: %T", err)
+		}
+		if errResp.StatusCode != 422 || len(errResp.Errors) != 2 {
+			t.Fatalf("unexpected error response: %+v", errResp)
+		}
+	})
+}
+
+func TestValidationError(t *testing.T) {
+	// Most options don't validate without any of their fields set.
+	options := []interface{ Validate() error }{
+		AdminTerraformVersionCreateOptions{},
+		AdminTerraformVersionUpdateOptions{Version: String("")},
+		AgentPoolCreateOptions{},
+		AgentPoolUpdateOptions{},
+		AgentTokenGenerateOptions{},
+		NotificationConfigurationCreateOptions{},
+		NotificationConfigurationUpdateOptions{},
+		OAuthClientCreateOptions{},
+		OAuthClientAddProjectsOptions{},
+		OAuthClientRemoveProjectsOptions{},
+		OrganizationListOptions{},
+		OrganizationCreateOptions{},
+		OrganizationUpdateOptions{},
+		OrganizationMembershipCreateOptions{},
+		PlanExportCreateOptions{},
+		PolicyCreateOptions{},
+		PolicyUpdateOptions{},
+		PolicySetCreateOptions{},
+		PolicySetUpdateOptions{},
+		PolicySetAddPoliciesOptions{},
+		PolicySetRemovePoliciesOptions{},
+		PolicySetAddWorkspacesOptions{},
+		PolicySetRemoveWorkspacesOptions{},
+		PolicySetParameterListOptions{},
+		PolicySetParameterCreateOptions{},
+		ProjectCreateOptions{},
+		ProjectUpdateOptions{},
+		RegistryModuleCreateWithVCSConnectionOptions{},
+		RunListOptions{},
+		RunCreateOptions{},
+		RunTaskCreateOptions{},
+		RunTaskUpdateOptions{},
+		RunTriggerListOptions{},
+		RunTriggerCreateOptions{},
+		SSHKeyCreateOptions{},
+		StateVersionListOptions{},
+		StateVersionCreateOptions{},
+		TeamCreateOptions{},
+		TeamUpdateOptions{},
+		TeamAccessListOptions{},
+		TeamAccessAddOptions{},
+		TeamAccessUpdateOptions{},
+		TeamMemberAddOptions{},
+		TeamMemberRemoveOptions{},
+		VariableCreateOptions{},
+		VariableUpdateOptions{},
+		WorkspaceListOptions{},
+		WorkspaceCreateOptions{},
+		WorkspaceUpdateOptions{},
+		WorkspaceAssignSSHKeyOptions{},
+		WorkspaceRemoteStateSharingOptions{},
+		WorkspaceRunTaskCreateOptions{},
+		WorkspaceRunTaskUpdateOptions{},
+		WorkspaceCreateOptions{Name: String("foo"), ExecutionMode: ExecutionMode("bogus")},
+		ProjectCreateOptions{Name: String("foo"), DefaultExecutionMode: ExecutionMode("bogus")},
+		RunTaskCreateOptions{Name: String("foo"), URL: String("https://example.com"), Category: String("task"), Global: &GlobalRunTaskOptions{Stages: []Stage{"bogus"}}},
+	}
+
+	failed := 0
+	for _, o := range options {
+		err := o.Validate()
+		if err == nil {
+			continue
+		}
+		failed++
+
+		if !errors.Is(err, ErrInvalidOptions) {
+			t.Fatalf("expected the error of %T to match %v, got: %v", o, ErrInvalidOptions, err)
+		}
+	}
+	if failed == 0 {
+		t.Fatal("expected some options to fail to validate")
+	}
+}
+
+func TestClient_decoder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/tfe/v2/ping":
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+		case "/api/tfe/v2/workspaces/ws-123456789":
+			w.Write([]byte(`{"data":{"id":"ws-123456789","type":"workspaces","attributes":{"name":"foo"}}}`))
+		case "/api/tfe/v2/organizations/foo/workspaces":
+			w.Write([]byte(`{"data":[{"id":"ws-123456789","type":"workspaces","attributes":{"name":"foo"}}],` +
+				`"meta":{"pagination":{"current-page":1,"total-count":1}}}`))
+		}
+	}))
+	defer ts.Close()
+
+	decoder := &countingDecoder{}
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Decoder:    decoder,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	w, err := client.Workspaces.ReadByID(ctx, "ws-123456789")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Name != "foo" {
+		t.Fatalf("expected workspace name foo, got: %q", w.Name)
+	}
+
+	wl, err := client.Workspaces.List(ctx, "foo", WorkspaceListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wl.Items) != 1 || wl.TotalCount != 1 {
+		t.Fatalf("expected 1 workspace, got: %d (total count %d)", len(wl.Items), wl.TotalCount)
+	}
+
+	if decoder.single != 1 {
+		t.Fatalf("expected 1 single payload decode, got: %d", decoder.single)
+	}
+	if decoder.many != 1 {
+		t.Fatalf("expected 1 list payload decode, got: %d", decoder.many)
+	}
+}
+
+func TestClient_entitlementsCache(t *testing.T) {
+	entitlementReads := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/tfe/v2/ping":
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+		case "/api/tfe/v2/organizations/foo/entitlement-set":
+			entitlementReads++
+			w.Write([]byte(`{"data":{"id":"org-foo","type":"entitlement-sets","attributes":{"teams":true}}}`))
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	t.Run("without a TTL", func(t *testing.T) {
+		entitlementReads = 0
+
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 2; i++ {
+			if _, err := client.Organizations.Entitlements(ctx, "foo"); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if entitlementReads != 2 {
+			t.Fatalf("expected 2 entitlement reads, got: %d", entitlementReads)
+		}
+	})
+
+	t.Run("with a TTL", func(t *testing.T) {
+		entitlementReads = 0
+
+		client, err := NewClient(&Config{
+			Address:              ts.URL,
+			Token:                "dummy-token",
+			HTTPClient:           ts.Client(),
+			EntitlementsCacheTTL: time.Minute,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 2; i++ {
+			e, err := client.Organizations.Entitlements(ctx, "foo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !e.Teams {
+				t.Fatal("expected teams to be entitled")
+			}
+
+			// Changing the result should not change the cached value.
+			e.Teams = false
+		}
+
+		if entitlementReads != 1 {
+			t.Fatalf("expected 1 entitlement read, got: %d", entitlementReads)
+		}
+
+		client.InvalidateEntitlements("foo")
+
+		if _, err := client.Organizations.Entitlements(ctx, "foo"); err != nil {
+			t.Fatal(err)
+		}
+
+		if entitlementReads != 2 {
+			t.Fatalf("expected 2 entitlement reads after invalidation, got: %d", entitlementReads)
+		}
+	})
+
+	t.Run("with an expired TTL", func(t *testing.T) {
+		entitlementReads = 0
+
+		client, err := NewClient(&Config{
+			Address:              ts.URL,
+			Token:                "dummy-token",
+			HTTPClient:           ts.Client(),
+			EntitlementsCacheTTL: time.Millisecond,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 2; i++ {
+			if _, err := client.Organizations.Entitlements(ctx, "foo"); err != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		if entitlementReads != 2 {
+			t.Fatalf("expected 2 entitlement reads, got: %d", entitlementReads)
+		}
+	})
+}
+
+type recordingMetrics struct {
+	requests []string
+	retries  []string
+}
+
+func (m *recordingMetrics) ObserveRequest(method, path string, status int, dur time.Duration) {
+	m.requests = append(m.requests, fmt.Sprintf("%s %s %d", method, path, status))
+}
+
+func (m *recordingMetrics) ObserveRetry(path string) {
+	m.retries = append(m.retries, path)
+}
+
+func TestClient_metrics(t *testing.T) {
+	workspaceReads := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/tfe/v2/ping":
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+		case "/api/tfe/v2/workspaces/ws-123456789":
+			workspaceReads++
+			if workspaceReads == 1 {
+				w.WriteHeader(429)
+				return
+			}
+			w.Write([]byte(`{"data":{"id":"ws-123456789","type":"workspaces"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	metrics := &recordingMetrics{}
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		Metrics:    metrics,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if _, err := client.Workspaces.ReadByID(ctx, "ws-123456789"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Workspaces.ReadByID(ctx, "ws-nonexisting"); err != ErrResourceNotFound {
+		t.Fatalf("expected %v, got: %v", ErrResourceNotFound, err)
+	}
+	if err := client.Ping(ctx); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	expectedRequests := []string{
+		"GET /api/tfe/v2/ping 204",
+		"GET /api/tfe/v2/workspaces/ws-123456789 200",
+		"GET /api/tfe/v2/workspaces/ws-nonexisting 404",
+		"GET /api/tfe/v2/account/details 404",
+	}
+	if !reflect.DeepEqual(metrics.requests, expectedRequests) {
+		t.Fatalf("expected requests %v, got: %v", expectedRequests, metrics.requests)
+	}
+
+	expectedRetries := []string{"/api/tfe/v2/workspaces/ws-123456789"}
+	if !reflect.DeepEqual(metrics.retries, expectedRetries) {
+		t.Fatalf("expected retries %v, got: %v", expectedRetries, metrics.retries)
+	}
+}
+
+func TestClient_defaultOrganization(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/tfe/v2/ping" {
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+			return
+		}
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:             ts.URL,
+		Token:               "dummy-token",
+		HTTPClient:          ts.Client(),
+		DefaultOrganization: "acme",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.DefaultOrganization != "acme" {
+		t.Fatalf("expected default organization %q, got: %q", "acme", client.DefaultOrganization)
+	}
+
+	ctx := context.Background()
+
+	t.Run("without an organization", func(t *testing.T) {
+		paths = nil
+		if _, err := client.Workspaces.List(ctx, "", WorkspaceListOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Teams.List(ctx, "", TeamListOptions{}); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{
+			"/api/tfe/v2/organizations/acme/workspaces",
+			"/api/tfe/v2/organizations/acme/teams",
+		}
+		if !reflect.DeepEqual(paths, expected) {
+			t.Fatalf("expected paths %v, got: %v", expected, paths)
+		}
+	})
+
+	t.Run("with an organization", func(t *testing.T) {
+		paths = nil
+		if _, err := client.Workspaces.List(ctx, "other", WorkspaceListOptions{}); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{"/api/tfe/v2/organizations/other/workspaces"}
+		if !reflect.DeepEqual(paths, expected) {
+			t.Fatalf("expected paths %v, got: %v", expected, paths)
+		}
+	})
+
+	t.Run("without any organization", func(t *testing.T) {
+		client.DefaultOrganization = ""
+		defer func() { client.DefaultOrganization = "acme" }()
+
+		_, err := client.Workspaces.List(ctx, "", WorkspaceListOptions{})
+		if err == nil || err.Error() != "organization is required" {
+			t.Fatalf("expected error %q, got: %v", "organization is required", err)
+		}
+	})
+}
+
+func TestClient_warningHook(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/tfe/v2/ping":
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+		case "/api/tfe/v2/workspaces/ws-123456789":
+			w.Header().Add("X-TFE-Deprecation", "this endpoint is deprecated")
+			w.Write([]byte(`{"data":{"id":"ws-123456789","type":"workspaces"},` +
+				`"meta":{"warnings":["the foo attribute is deprecated",{"title":"Sunset","detail":"use bar instead"}]}}`))
+		case "/api/tfe/v2/organizations/acme/workspaces":
+			w.Write([]byte(`{"data":[],"meta":{"warnings":["listing is deprecated"],"pagination":{}}}`))
+		case "/api/tfe/v2/workspaces/ws-nonexisting":
+			w.Header().Add("X-TFE-Deprecation", "this endpoint is deprecated")
+			w.WriteHeader(404)
+		case "/api/tfe/v2/workspaces/ws-truncated":
+			// Promise more than is written, so reading the body fails.
+			w.Header().Set("Content-Length", "100")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"meta":`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	var warnings []string
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+		WarningHook: func(method, path, warning string) {
+			warnings = append(warnings, fmt.Sprintf("%s %s: %s", method, path, warning))
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	t.Run("with a single resource", func(t *testing.T) {
+		warnings = nil
+		w, err := client.Workspaces.ReadByID(ctx, "ws-123456789")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w.ID != "ws-123456789" {
+			t.Fatalf("expected workspace %q, got: %q", "ws-123456789", w.ID)
+		}
+
+		expected := []string{
+			"GET /api/tfe/v2/workspaces/ws-123456789: this endpoint is deprecated",
+			"GET /api/tfe/v2/workspaces/ws-123456789: the foo attribute is deprecated",
+			"GET /api/tfe/v2/workspaces/ws-123456789: use bar instead",
+		}
+		if !reflect.DeepEqual(warnings, expected) {
+			t.Fatalf("expected warnings %v, got: %v", expected, warnings)
+		}
+	})
+
+	t.Run("with a list of resources", func(t *testing.T) {
+		warnings = nil
+		if _, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{}); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{"GET /api/tfe/v2/organizations/acme/workspaces: listing is deprecated"}
+		if !reflect.DeepEqual(warnings, expected) {
+			t.Fatalf("expected warnings %v, got: %v", expected, warnings)
+		}
+	})
+
+	t.Run("with an error response", func(t *testing.T) {
+		warnings = nil
+		if _, err := client.Workspaces.ReadByID(ctx, "ws-nonexisting"); err != ErrResourceNotFound {
+			t.Fatalf("expected %v, got: %v", ErrResourceNotFound, err)
+		}
+
+		expected := []string{"GET /api/tfe/v2/workspaces/ws-nonexisting: this endpoint is deprecated"}
+		if !reflect.DeepEqual(warnings, expected) {
+			t.Fatalf("expected warnings %v, got: %v", expected, warnings)
+		}
+	})
+
+	t.Run("when reading the body of a successful request fails", func(t *testing.T) {
+		warnings = nil
+		if err := client.Workspaces.DeleteByID(ctx, "ws-truncated"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(warnings) != 0 {
+			t.Fatalf("expected no warnings, got: %v", warnings)
+		}
+	})
+}
+
+func TestClient_ping(t *testing.T) {
+	status := 200
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/api/tfe/v2/ping":
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+		case "/api/tfe/v2/account/details":
+			requests++
+			if r.Header.Get("Authorization") != "Bearer dummy-token" {
+				w.WriteHeader(401)
+				return
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{"data":{"id":"user-123","type":"users"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	cases := []struct {
+		status int
+		err    error
+	}{
+		{200, nil},
+		{401, ErrUnauthorized},
+		{403, ErrInsufficientPermissions},
+		{500, ErrServiceUnavailable},
+		{503, ErrServiceUnavailable},
+	}
+	for _, tc := range cases {
+		status = tc.status
+		requests = 0
+
+		err := client.Ping(ctx)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("expected %v for status %d, got: %v", tc.err, tc.status, err)
+		}
+		if requests != 1 {
+			t.Fatalf("expected a single request for status %d, got: %d", tc.status, requests)
+		}
+	}
+
+	t.Run("when the API is unreachable", func(t *testing.T) {
+		ts.Close()
+
+		err := client.Ping(ctx)
+		if !errors.Is(err, ErrUnreachable) {
+			t.Fatalf("expected %v, got: %v", ErrUnreachable, err)
+		}
+	})
+
+	t.Run("with a canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		if err := client.Ping(ctx); err != context.Canceled {
+			t.Fatalf("expected %v, got: %v", context.Canceled, err)
+		}
+	})
+}
+
+func TestClient_artifactRedirects(t *testing.T) {
+	blobs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("expected no Authorization header to be sent to the blob store, got: %q", auth)
+		}
+		w.Write([]byte("artifact"))
+	}))
+	defer blobs.Close()
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/ping":
+			w.WriteHeader(204)
+		case "/api/tfe/v2/plan-exports/pe-123/download":
+			http.Redirect(w, r, blobs.URL+"/blobs/plan-export", http.StatusTemporaryRedirect)
+		case "/api/tfe/v2/state-versions/sv-123/download":
+			// Redirect to the API first, which should keep the token.
+			http.Redirect(w, r, ts.URL+"/api/tfe/v2/state-versions/sv-123/content", http.StatusFound)
+		case "/api/tfe/v2/state-versions/sv-123/content":
+			if r.Header.Get("Authorization") != "Bearer dummy-token" {
+				t.Errorf("expected the token to be sent to the API, got: %q", r.Header.Get("Authorization"))
+			}
+			http.Redirect(w, r, blobs.URL+"/blobs/state", http.StatusFound)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	httpClient := ts.Client()
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if httpClient.CheckRedirect != nil {
+		t.Fatal("expected the provided HTTP client to be left unchanged")
+	}
+
+	ctx := context.Background()
+
+	t.Run("when downloading a plan export", func(t *testing.T) {
+		data, err := client.PlanExports.Download(ctx, "pe-123")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "artifact" {
+			t.Fatalf("expected %q, got: %q", "artifact", data)
+		}
+	})
+
+	t.Run("when downloading a state through the API", func(t *testing.T) {
+		data, err := client.StateVersions.Download(ctx, ts.URL+"/api/tfe/v2/state-versions/sv-123/download")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "artifact" {
+			t.Fatalf("expected %q, got: %q", "artifact", data)
+		}
+	})
+
+	t.Run("when downloading a state from another host", func(t *testing.T) {
+		data, err := client.StateVersions.Download(ctx, blobs.URL+"/blobs/state")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "artifact" {
+			t.Fatalf("expected %q, got: %q", "artifact", data)
+		}
+	})
+}
+
+func TestClient_clone(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/tfe/v2/ping" {
+			w.Header().Set("X-RateLimit-Limit", "30")
+			w.WriteHeader(204)
+			return
+		}
+		requests = append(requests, r.Header.Get("Authorization")+" "+r.URL.Path)
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:             ts.URL,
+		Token:               "dummy-token",
+		HTTPClient:          ts.Client(),
+		DefaultOrganization: "acme",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	t.Run("with another token", func(t *testing.T) {
+		requests = nil
+
+		clone := client.WithToken("other-token")
+		if clone.http.HTTPClient.Transport != client.http.HTTPClient.Transport || clone.limiter != client.limiter {
+			t.Fatal("expected the clone to share the HTTP transport and rate limiter")
+		}
+		if clone.entitlements == client.entitlements {
+			t.Fatal("expected the clone to have its own entitlements cache")
+		}
+
+		if _, err := clone.Workspaces.List(ctx, "", WorkspaceListOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Workspaces.List(ctx, "", WorkspaceListOptions{}); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{
+			"Bearer other-token /api/tfe/v2/organizations/acme/workspaces",
+			"Bearer dummy-token /api/tfe/v2/organizations/acme/workspaces",
+		}
+		if !reflect.DeepEqual(requests, expected) {
+			t.Fatalf("expected requests %v, got: %v", expected, requests)
+		}
+	})
+
+	t.Run("with another organization", func(t *testing.T) {
+		requests = nil
+
+		clone := client.WithOrganization("other")
+		if _, err := clone.Teams.List(ctx, "", TeamListOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if client.DefaultOrganization != "acme" {
+			t.Fatalf("expected the default organization to be unchanged, got: %q", client.DefaultOrganization)
+		}
+
+		expected := []string{"Bearer dummy-token /api/tfe/v2/organizations/other/teams"}
+		if !reflect.DeepEqual(requests, expected) {
+			t.Fatalf("expected requests %v, got: %v", expected, requests)
+		}
+	})
+}
+
+func TestClient_cloneRetryPolicy(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/tfe/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(502)
+	}))
+	defer ts.Close()
+
+	var parentRetries int32
+	client, err := NewClient(&Config{
+		Address:        ts.URL,
+		Token:          "dummy-token",
+		HTTPClient:     ts.Client(),
+		RetryMax:       2,
+		RetryBaseDelay: time.Millisecond,
+		RetryLogHook: func(attemptNum int, resp *http.Response) {
+			atomic.AddInt32(&parentRetries, 1)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.RetryServerErrors(true)
+
+	var cloneRetries int32
+	clone := client.Clone()
+	clone.RetryServerErrors(false)
+	clone.SetRetryLogHook(func(attemptNum int, resp *http.Response) {
+		atomic.AddInt32(&cloneRetries, 1)
+	})
+
+	ctx := context.Background()
+
+	t.Run("when the clone doesn't retry server errors", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+
+		if _, err := clone.Organizations.Read(ctx, "broken"); err == nil {
+			t.Fatal("expected an error")
+		}
+		if n := atomic.LoadInt32(&requests); n != 1 {
+			t.Fatalf("expected 1 request, got: %d", n)
+		}
+	})
+
+	t.Run("when the parent still retries server errors", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+
+		if _, err := client.Organizations.Read(ctx, "broken"); err == nil {
+			t.Fatal("expected an error")
+		}
+		if n := atomic.LoadInt32(&requests); n != 3 {
+			t.Fatalf("expected 3 requests, got: %d", n)
+		}
+		if n := atomic.LoadInt32(&parentRetries); n != 2 {
+			t.Fatalf("expected the parent hook to be called 2 times, got: %d", n)
+		}
+		if n := atomic.LoadInt32(&cloneRetries); n != 0 {
+			t.Fatalf("expected the clone hook not to be called, got: %d", n)
+		}
+	})
+
+	t.Run("when the clone retries with its own hook", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		atomic.StoreInt32(&parentRetries, 0)
+		clone.RetryServerErrors(true)
+
+		if _, err := clone.Organizations.Read(ctx, "broken"); err == nil {
+			t.Fatal("expected an error")
+		}
+		if n := atomic.LoadInt32(&cloneRetries); n != 2 {
+			t.Fatalf("expected the clone hook to be called 2 times, got: %d", n)
+		}
+		if n := atomic.LoadInt32(&parentRetries); n != 0 {
+			t.Fatalf("expected the parent hook not to be called, got: %d", n)
+		}
+	})
+}
+
+func TestClient_listPages(t *testing.T) {
+	t.Run("until there is no next page", func(t *testing.T) {
+		var pages []int
+		err := listPages(2, func(pageNumber int) (*Pagination, bool, error) {
+			pages = append(pages, pageNumber)
+			if pageNumber == 4 {
+				return &Pagination{CurrentPage: 4}, false, nil
+			}
+			return &Pagination{CurrentPage: pageNumber, NextPage: pageNumber + 1}, false, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(pages, []int{2, 3, 4}) {
+			t.Fatalf("expected pages [2 3 4], got: %v", pages)
+		}
+	})
+
+	t.Run("when done early", func(t *testing.T) {
+		calls := 0
+		err := listPages(0, func(pageNumber int) (*Pagination, bool, error) {
+			calls++
+			return &Pagination{CurrentPage: 1, NextPage: 2}, true, nil
+		})
+		if err != nil || calls != 1 {
+			t.Fatalf("expected 1 call without error, got %d calls and error: %v", calls, err)
+		}
+	})
+
+	t.Run("when the next page is not after the current page", func(t *testing.T) {
+		calls := 0
+		err := listPages(0, func(pageNumber int) (*Pagination, bool, error) {
+			calls++
+			return &Pagination{CurrentPage: 1, NextPage: 1}, false, nil
+		})
+		if err != nil || calls != 1 {
+			t.Fatalf("expected 1 call without error, got %d calls and error: %v", calls, err)
+		}
+	})
+
+	t.Run("when the server keeps returning a next page", func(t *testing.T) {
+		calls := 0
+		err := listPages(0, func(pageNumber int) (*Pagination, bool, error) {
+			calls++
+			return &Pagination{CurrentPage: calls, NextPage: calls + 1}, false, nil
+		})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if calls != maxPages {
+			t.Fatalf("expected %d calls, got: %d", maxPages, calls)
+		}
+	})
+}
+
 func setupEnvVars(token, address string) func() {
 	origToken := os.Getenv("TFE_TOKEN")
 	origAddress := os.Getenv("TFE_ADDRESS")
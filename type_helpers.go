@@ -1,5 +1,7 @@
 package tfe
 
+import "time"
+
 // Access returns a pointer to the given team access type.
 func Access(v AccessType) *AccessType {
 	return &v
@@ -25,6 +27,11 @@ func EnforcementMode(v EnforcementLevel) *EnforcementLevel {
 	return &v
 }
 
+// ExecutionMode returns a pointer to the given execution mode.
+func ExecutionMode(v ExecutionModeType) *ExecutionModeType {
+	return &v
+}
+
 // Int returns a pointer to the given int.
 func Int(v int) *int {
 	return &v
@@ -45,12 +52,54 @@ func PlanExportType(v PlanExportDataType) *PlanExportDataType {
 	return &v
 }
 
+// RunsPermission returns a pointer to the given runs permission type.
+func RunsPermission(v RunsPermissionType) *RunsPermissionType {
+	return &v
+}
+
+// RunStatusFilter returns a pointer to the given run status.
+func RunStatusFilter(v RunStatus) *RunStatus {
+	return &v
+}
+
+// RunTriggerFilter returns a pointer to the given run trigger filter type.
+func RunTriggerFilter(v RunTriggerFilterType) *RunTriggerFilterType {
+	return &v
+}
+
 // ServiceProvider returns a pointer to the given service provider type.
 func ServiceProvider(v ServiceProviderType) *ServiceProviderType {
 	return &v
 }
 
+// StateVersionsPermission returns a pointer to the given state versions
+// permission type.
+func StateVersionsPermission(v StateVersionsPermissionType) *StateVersionsPermissionType {
+	return &v
+}
+
 // String returns a pointer to the given string.
 func String(v string) *string {
 	return &v
 }
+
+// TaskEnforcement returns a pointer to the given run task enforcement level.
+func TaskEnforcement(v TaskEnforcementLevel) *TaskEnforcementLevel {
+	return &v
+}
+
+// TeamVisibility returns a pointer to the given team visibility type.
+func TeamVisibility(v TeamVisibilityType) *TeamVisibilityType {
+	return &v
+}
+
+// Time returns a pointer to the given time.
+func Time(v time.Time) *time.Time {
+	return &v
+}
+
+// VariablesPermission returns a pointer to the given variables permission
+// type.
+func VariablesPermission(v VariablesPermissionType) *VariablesPermissionType {
+	return &v
+}
@@ -26,26 +26,37 @@ type users struct {
 
 // User represents a Terraform Enterprise user.
 type User struct {
-	ID               string     `jsonapi:"primary,users"`
-	AvatarURL        string     `jsonapi:"attr,avatar-url"`
-	Email            string     `jsonapi:"attr,email"`
-	IsServiceAccount bool       `jsonapi:"attr,is-service-account"`
-	TwoFactor        *TwoFactor `jsonapi:"attr,two-factor"`
-	UnconfirmedEmail string     `jsonapi:"attr,unconfirmed-email"`
-	Username         string     `jsonapi:"attr,username"`
-	V2Only           bool       `jsonapi:"attr,v2-only"`
+	ID                  string           `jsonapi:"primary,users"`
+	AvatarURL           string           `jsonapi:"attr,avatar-url"`
+	Email               string           `jsonapi:"attr,email"`
+	IsServiceAccount    bool             `jsonapi:"attr,is-service-account"`
+	Permissions         *UserPermissions `jsonapi:"attr,permissions"`
+	TwoFactor           *TwoFactor       `jsonapi:"attr,two-factor"`
+	TwoFactorConformant bool             `jsonapi:"attr,two-factor-conformant"`
+	UnconfirmedEmail    string           `jsonapi:"attr,unconfirmed-email"`
+	Username            string           `jsonapi:"attr,username"`
+	V2Only              bool             `jsonapi:"attr,v2-only"`
 
 	// Relations
 	// AuthenticationTokens *AuthenticationTokens `jsonapi:"relation,authentication-tokens"`
 }
 
-// TwoFactor represents the organization permissions.
+// UserPermissions represents the user permissions.
+type UserPermissions struct {
+	CanCreateOrganizations bool `json:"can-create-organizations"`
+	CanChangeEmail         bool `json:"can-change-email"`
+	CanChangeUsername      bool `json:"can-change-username"`
+	CanManageUserTokens    bool `json:"can-manage-user-tokens"`
+}
+
+// TwoFactor represents the two factor authentication status of a user.
 type TwoFactor struct {
 	Enabled  bool `json:"enabled"`
 	Verified bool `json:"verified"`
 }
 
-// ReadCurrent reads the details of the currently authenticated user.
+// ReadCurrent reads the details of the currently authenticated user. An
+// invalid or expired token results in ErrUnauthorized.
 func (s *users) ReadCurrent(ctx context.Context) (*User, error) {
 	req, err := s.client.newRequest("GET", "account/details", nil)
 	if err != nil {
@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,49 @@ func TestUsersReadCurrent(t *testing.T) {
 	})
 }
 
+func TestUsersReadCurrentDetails(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/account/details", r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer valid-token" {
+			w.WriteHeader(401)
+			w.Write([]byte(`{"errors": [{"status": "401", "title": "unauthorized"}]}`))
+			return
+		}
+
+		w.Write([]byte(`{"data": {"id": "user-123", "type": "users", "attributes": {
+			"username": "admin", "email": "admin@example.com",
+			"avatar-url": "https://www.gravatar.com/avatar/123",
+			"two-factor": {"enabled": true, "verified": true},
+			"permissions": {"can-create-organizations": true, "can-change-email": true,
+				"can-change-username": false, "can-manage-user-tokens": true}}}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with a valid token", func(t *testing.T) {
+		u, err := client.WithToken("valid-token").Users.ReadCurrent(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", u.ID)
+		assert.Equal(t, "admin", u.Username)
+		assert.Equal(t, "admin@example.com", u.Email)
+		assert.Equal(t, "https://www.gravatar.com/avatar/123", u.AvatarURL)
+
+		require.NotNil(t, u.TwoFactor)
+		assert.True(t, u.TwoFactor.Enabled)
+
+		require.NotNil(t, u.Permissions)
+		assert.True(t, u.Permissions.CanCreateOrganizations)
+		assert.False(t, u.Permissions.CanChangeUsername)
+		assert.True(t, u.Permissions.CanManageUserTokens)
+	})
+
+	t.Run("with an invalid token", func(t *testing.T) {
+		u, err := client.WithToken("invalid-token").Users.ReadCurrent(ctx)
+		assert.Nil(t, u)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+}
+
 func TestUsersUpdate(t *testing.T) {
 	t.Skip("Unsupported resource - deprecated profile")
 	client := testClient(t)
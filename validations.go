@@ -17,3 +17,12 @@ func validString(v *string) bool {
 func validStringID(v *string) bool {
 	return v != nil && reStringID.MatchString(*v)
 }
+
+// A regular expression used to validate auto destroy activity durations.
+var reActivityDuration = regexp.MustCompile(`^[1-9][0-9]*[dh]$`)
+
+// validActivityDuration checks if the given string pointer is non-nil and
+// contains a duration in days or hours, e.g. "14d" or "12h".
+func validActivityDuration(v *string) bool {
+	return v != nil && reActivityDuration.MatchString(*v)
+}
@@ -52,7 +52,9 @@ type VariableList struct {
 	Items []*Variable
 }
 
-// Variable represents a Terraform Enterprise variable.
+// Variable represents a Terraform Enterprise variable. The value of a
+// sensitive variable is never returned by the API, so Value is always empty
+// for sensitive variables, including in the result of an update.
 type Variable struct {
 	ID        string       `jsonapi:"primary,vars"`
 	Key       string       `jsonapi:"attr,key"`
@@ -112,22 +114,47 @@ type VariableCreateOptions struct {
 	Sensitive *bool `jsonapi:"attr,sensitive,omitempty"`
 }
 
-func (o VariableCreateOptions) valid() error {
+// Validate checks the variable create options for errors, without making an API
+// request.
+func (o VariableCreateOptions) Validate() error {
 	if !validString(o.Key) {
-		return errors.New("key is required")
+		return validationError("key is required")
 	}
 	if o.Category == nil {
-		return errors.New("category is required")
+		return validationError("category is required")
+	}
+	if !validVariableCategory(*o.Category) {
+		return validationError("invalid value for category")
 	}
 	return nil
 }
 
+// EnvVariable returns the options to create an environment variable with the
+// given key and value.
+func EnvVariable(key, value string) VariableCreateOptions {
+	return VariableCreateOptions{
+		Key:      String(key),
+		Value:    String(value),
+		Category: Category(CategoryEnv),
+	}
+}
+
+// TerraformVariable returns the options to create a Terraform variable with
+// the given key and value.
+func TerraformVariable(key, value string) VariableCreateOptions {
+	return VariableCreateOptions{
+		Key:      String(key),
+		Value:    String(value),
+		Category: Category(CategoryTerraform),
+	}
+}
+
 // Create is used to create a new variable.
 func (s *variables) Create(ctx context.Context, workspaceID string, options VariableCreateOptions) (*Variable, error) {
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -184,6 +211,9 @@ type VariableUpdateOptions struct {
 	// The value of the variable.
 	Value *string `jsonapi:"attr,value,omitempty"`
 
+	// Whether this is a Terraform or environment variable.
+	Category *CategoryType `jsonapi:"attr,category,omitempty"`
+
 	// Whether to evaluate the value of the variable as a string of HCL code.
 	HCL *bool `jsonapi:"attr,hcl,omitempty"`
 
@@ -191,7 +221,17 @@ type VariableUpdateOptions struct {
 	Sensitive *bool `jsonapi:"attr,sensitive,omitempty"`
 }
 
-// Update values of an existing variable.
+// Validate checks the variable update options for errors, without making an API
+// request.
+func (o VariableUpdateOptions) Validate() error {
+	if o.Category != nil && !validVariableCategory(*o.Category) {
+		return validationError("invalid value for category")
+	}
+	return nil
+}
+
+// Update values of an existing variable. The returned variable doesn't
+// contain the value when the variable is sensitive.
 func (s *variables) Update(ctx context.Context, workspaceID string, variableID string, options VariableUpdateOptions) (*Variable, error) {
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
@@ -199,6 +239,9 @@ func (s *variables) Update(ctx context.Context, workspaceID string, variableID s
 	if !validStringID(&variableID) {
 		return nil, errors.New("invalid value for variable ID")
 	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = variableID
@@ -235,3 +278,13 @@ func (s *variables) Delete(ctx context.Context, workspaceID string, variableID s
 
 	return s.client.do(ctx, req, nil)
 }
+
+// validVariableCategory reports whether the category can be used for a
+// workspace variable. The policy-set category is for policy set parameters.
+func validVariableCategory(v CategoryType) bool {
+	switch v {
+	case CategoryEnv, CategoryTerraform:
+		return true
+	}
+	return false
+}
@@ -142,6 +142,17 @@ func TestVariablesCreate(t *testing.T) {
 		assert.EqualError(t, err, "category is required")
 	})
 
+	t.Run("when options has an invalid category", func(t *testing.T) {
+		options := VariableCreateOptions{
+			Key:      String(randomString(t)),
+			Value:    String(randomString(t)),
+			Category: Category(CategoryPolicySet),
+		}
+
+		_, err := client.Variables.Create(ctx, wTest.ID, options)
+		assert.EqualError(t, err, "invalid value for category")
+	})
+
 	t.Run("when workspace ID is invalid", func(t *testing.T) {
 		options := VariableCreateOptions{
 			Key:      String(randomString(t)),
@@ -154,6 +165,29 @@ func TestVariablesCreate(t *testing.T) {
 	})
 }
 
+func TestVariableCreateOptionsHelpers(t *testing.T) {
+	t.Run("with an environment variable", func(t *testing.T) {
+		options := EnvVariable("AWS_REGION", "eu-west-1")
+		require.NoError(t, options.Validate())
+		assert.Equal(t, "AWS_REGION", *options.Key)
+		assert.Equal(t, "eu-west-1", *options.Value)
+		assert.Equal(t, CategoryEnv, *options.Category)
+	})
+
+	t.Run("with a Terraform variable", func(t *testing.T) {
+		options := TerraformVariable("region", "eu-west-1")
+		require.NoError(t, options.Validate())
+		assert.Equal(t, "region", *options.Key)
+		assert.Equal(t, "eu-west-1", *options.Value)
+		assert.Equal(t, CategoryTerraform, *options.Category)
+	})
+
+	t.Run("when updating with an invalid category", func(t *testing.T) {
+		options := VariableUpdateOptions{Category: Category(CategoryPolicySet)}
+		assert.EqualError(t, options.Validate(), "invalid value for category")
+	})
+}
+
 func TestVariablesRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
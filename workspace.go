@@ -1,11 +1,19 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"reflect"
+	"strings"
+	"sync"
 	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/svanharmelen/jsonapi"
 )
 
 // Compile-time proof of interface implementation.
@@ -19,21 +27,40 @@ type Workspaces interface {
 	// List all the workspaces within an organization.
 	List(ctx context.Context, organization string, options WorkspaceListOptions) (*WorkspaceList, error)
 
+	// ListAll lists the workspaces within an organization across all pages.
+	ListAll(ctx context.Context, organization string, options WorkspaceListOptions) ([]*Workspace, error)
+
 	// Create is used to create a new workspace.
 	Create(ctx context.Context, organization string, options WorkspaceCreateOptions) (*Workspace, error)
 
 	// Read a workspace by its name.
 	Read(ctx context.Context, organization string, workspace string) (*Workspace, error)
 
+	// ReadWithOptions reads a workspace by its name using the given options.
+	ReadWithOptions(ctx context.Context, organization string, workspace string, options WorkspaceReadOptions) (*Workspace, error)
+
+	// Exists reports whether a workspace with the given name exists.
+	Exists(ctx context.Context, organization string, workspace string) (bool, error)
+
 	// ReadByID reads a workspace by its ID.
 	ReadByID(ctx context.Context, workspaceID string) (*Workspace, error)
 
+	// ReadByIDWithOptions reads a workspace by its ID using the given options.
+	ReadByIDWithOptions(ctx context.Context, workspaceID string, options WorkspaceReadOptions) (*Workspace, error)
+
+	// ReadByIDs concurrently reads the workspaces with the given IDs.
+	ReadByIDs(ctx context.Context, workspaceIDs []string, options BatchReadOptions) (map[string]*Workspace, error)
+
 	// Update settings of an existing workspace.
 	Update(ctx context.Context, organization string, workspace string, options WorkspaceUpdateOptions) (*Workspace, error)
 
 	// UpdateByID updates the settings of an existing workspace.
 	UpdateByID(ctx context.Context, workspaceID string, options WorkspaceUpdateOptions) (*Workspace, error)
 
+	// Mutate reads a workspace, applies the given mutation to it and
+	// updates only the settings that were changed by the mutation.
+	Mutate(ctx context.Context, workspaceID string, mutate func(*Workspace)) (*Workspace, error)
+
 	// Delete a workspace by its name.
 	Delete(ctx context.Context, organization string, workspace string) error
 
@@ -46,13 +73,17 @@ type Workspaces interface {
 	// RemoveVCSConnectionByID removes a VCS connection from a workspace.
 	RemoveVCSConnectionByID(ctx context.Context, workspaceID string) (*Workspace, error)
 
-	// Lock a workspace by its ID.
+	// Lock a workspace by its ID. Use Permissions.CanLock to check whether
+	// the workspace can be locked with the current token.
 	Lock(ctx context.Context, workspaceID string, options WorkspaceLockOptions) (*Workspace, error)
 
-	// Unlock a workspace by its ID.
+	// Unlock a workspace by its ID. Use Permissions.CanUnlock to check
+	// whether the workspace can be unlocked with the current token.
 	Unlock(ctx context.Context, workspaceID string) (*Workspace, error)
 
-	// ForceUnlock a workspace by its ID.
+	// ForceUnlock a workspace by its ID. Use Permissions.CanForceUnlock to
+	// check whether the workspace can be force-unlocked with the current
+	// token.
 	ForceUnlock(ctx context.Context, workspaceID string) (*Workspace, error)
 
 	// AssignSSHKey to a workspace.
@@ -60,6 +91,17 @@ type Workspaces interface {
 
 	// UnassignSSHKey from a workspace.
 	UnassignSSHKey(ctx context.Context, workspaceID string) (*Workspace, error)
+
+	// RemoveAgentPool detaches the agent pool from a workspace.
+	RemoveAgentPool(ctx context.Context, workspaceID string) (*Workspace, error)
+
+	// RemoteStateConsumers lists the workspaces allowed to access the state
+	// of the given workspace.
+	RemoteStateConsumers(ctx context.Context, workspaceID string, options ListOptions) (*WorkspaceList, error)
+
+	// ConfigureRemoteStateSharing sets the global remote state flag and the
+	// explicit set of remote state consumers of a workspace.
+	ConfigureRemoteStateSharing(ctx context.Context, workspaceID string, options WorkspaceRemoteStateSharingOptions) (*Workspace, error)
 }
 
 // workspaces implements Workspaces.
@@ -75,28 +117,86 @@ type WorkspaceList struct {
 
 // Workspace represents a Terraform Enterprise workspace.
 type Workspace struct {
-	ID                   string                `jsonapi:"primary,workspaces"`
-	Actions              *WorkspaceActions     `jsonapi:"attr,actions"`
-	AutoApply            bool                  `jsonapi:"attr,auto-apply"`
-	CanQueueDestroyPlan  bool                  `jsonapi:"attr,can-queue-destroy-plan"`
-	CreatedAt            time.Time             `jsonapi:"attr,created-at,iso8601"`
-	Environment          string                `jsonapi:"attr,environment"`
-	FileTriggersEnabled  bool                  `jsonapi:"attr,file-triggers-enabled"`
-	Locked               bool                  `jsonapi:"attr,locked"`
-	MigrationEnvironment string                `jsonapi:"attr,migration-environment"`
-	Name                 string                `jsonapi:"attr,name"`
-	Operations           bool                  `jsonapi:"attr,operations"`
-	Permissions          *WorkspacePermissions `jsonapi:"attr,permissions"`
-	QueueAllRuns         bool                  `jsonapi:"attr,queue-all-runs"`
-	TerraformVersion     string                `jsonapi:"attr,terraform-version"`
-	TriggerPrefixes      []string              `jsonapi:"attr,trigger-prefixes"`
-	VCSRepo              *VCSRepo              `jsonapi:"attr,vcs-repo"`
-	WorkingDirectory     string                `jsonapi:"attr,working-directory"`
+	ID                          string                `jsonapi:"primary,workspaces"`
+	Actions                     *WorkspaceActions     `jsonapi:"attr,actions"`
+	AutoApply                   bool                  `jsonapi:"attr,auto-apply"`
+	AutoDestroyActivityDuration string                `jsonapi:"attr,auto-destroy-activity-duration"`
+	CanQueueDestroyPlan         bool                  `jsonapi:"attr,can-queue-destroy-plan"`
+	CreatedAt                   time.Time             `jsonapi:"attr,created-at,iso8601"`
+	Environment                 string                `jsonapi:"attr,environment"`
+	ExecutionMode               ExecutionModeType     `jsonapi:"attr,execution-mode"`
+	FileTriggersEnabled         bool                  `jsonapi:"attr,file-triggers-enabled"`
+	GlobalRemoteState           bool                  `jsonapi:"attr,global-remote-state"`
+	InheritsProjectAutoDestroy  bool                  `jsonapi:"attr,inherits-project-auto-destroy"`
+	Locked                      bool                  `jsonapi:"attr,locked"`
+	MigrationEnvironment        string                `jsonapi:"attr,migration-environment"`
+	Name                        string                `jsonapi:"attr,name"`
+	Operations                  bool                  `jsonapi:"attr,operations"`
+	Permissions                 *WorkspacePermissions `jsonapi:"attr,permissions"`
+	QueueAllRuns                bool                  `jsonapi:"attr,queue-all-runs"`
+	ResourceCount               int                   `jsonapi:"attr,resource-count"`
+	SourceName                  string                `jsonapi:"attr,source-name"`
+	SourceURL                   string                `jsonapi:"attr,source-url"`
+	TagNames                    []string              `jsonapi:"attr,tag-names"`
+	TerraformVersion            string                `jsonapi:"attr,terraform-version"`
+	TriggerPrefixes             []string              `jsonapi:"attr,trigger-prefixes"`
+	VCSRepo                     *VCSRepo              `jsonapi:"attr,vcs-repo"`
+	WorkingDirectory            string                `jsonapi:"attr,working-directory"`
 
 	// Relations
-	CurrentRun   *Run          `jsonapi:"relation,current-run"`
-	Organization *Organization `jsonapi:"relation,organization"`
-	SSHKey       *SSHKey       `jsonapi:"relation,ssh-key"`
+	AgentPool                   *AgentPool            `jsonapi:"relation,agent-pool"`
+	CurrentConfigurationVersion *ConfigurationVersion `jsonapi:"relation,current-configuration-version"`
+	CurrentRun                  *Run                  `jsonapi:"relation,current-run"`
+	Organization                *Organization         `jsonapi:"relation,organization"`
+	Project                     *Project              `jsonapi:"relation,project"`
+	SSHKey                      *SSHKey               `jsonapi:"relation,ssh-key"`
+
+	// LockedBy holds the run, user or team that locked the workspace, or
+	// nil when the workspace is not locked.
+	LockedBy *LockedByChoice
+
+	// Meta holds the resource meta of the workspace, like the Terraform
+	// version compatibility information.
+	Meta ResourceMeta
+}
+
+// LockedByChoice holds the resource that locked a workspace. The locked-by
+// relationship is polymorphic, so only one of the fields is set. Only the
+// ID of the resource is known; use the related service to read it.
+type LockedByChoice struct {
+	Run  *Run
+	User *User
+	Team *Team
+}
+
+// decodeRawResource decodes the polymorphic locked-by relationship, which
+// the jsonapi package can not decode into a single relation field.
+func (w *Workspace) decodeRawResource(raw *rawResource) error {
+	w.LockedBy = nil
+
+	rel, ok := raw.Relationships["locked-by"]
+	if !ok || len(rel.Data) == 0 || string(rel.Data) == "null" {
+		return nil
+	}
+
+	var data struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(rel.Data, &data); err != nil {
+		return err
+	}
+
+	switch data.Type {
+	case "runs":
+		w.LockedBy = &LockedByChoice{Run: &Run{ID: data.ID}}
+	case "users":
+		w.LockedBy = &LockedByChoice{User: &User{ID: data.ID}}
+	case "teams":
+		w.LockedBy = &LockedByChoice{Team: &Team{ID: data.ID}}
+	}
+
+	return nil
 }
 
 // VCSRepo contains the configuration of a VCS integration.
@@ -132,12 +232,52 @@ type WorkspaceListOptions struct {
 
 	// A search string (partial workspace name) used to filter the results.
 	Search *string `url:"search[name],omitempty"`
+
+	// A comma separated list of tags used to filter the results. Only the
+	// workspaces having all the given tags are listed.
+	Tags *string `url:"search[tags],omitempty"`
+
+	// Only list the workspaces whose current run has the given status,
+	// e.g. RunErrored to list all workspaces with a failed last run.
+	CurrentRunStatus *RunStatus `url:"filter[current-run][status],omitempty"`
+
+	// The attribute to sort the results by. Prefix the attribute with a "-"
+	// to sort in descending order, e.g. "-current-run.created-at" or
+	// "-resource-count" to list the workspaces managing the most resources
+	// first.
+	Sort *string `url:"sort,omitempty"`
+}
+
+// The attributes workspaces can be sorted by.
+var workspaceSortKeys = map[string]bool{
+	"name":                   true,
+	"current-run.created-at": true,
+	"resource-count":         true,
+}
+
+// Validate checks the workspace list options for errors, without making an
+// API request.
+func (o WorkspaceListOptions) Validate() error {
+	if o.Tags != nil && !validString(o.Tags) {
+		return validationError("invalid value for tags")
+	}
+	if o.CurrentRunStatus != nil && *o.CurrentRunStatus == "" {
+		return validationError("invalid value for current run status")
+	}
+	if o.Sort != nil && !workspaceSortKeys[strings.TrimPrefix(*o.Sort, "-")] {
+		return validationError("invalid value for sort")
+	}
+	return nil
 }
 
 // List all the workspaces within an organization.
 func (s *workspaces) List(ctx context.Context, organization string, options WorkspaceListOptions) (*WorkspaceList, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
 	}
 
 	u := fmt.Sprintf("organizations/%s/workspaces", url.QueryEscape(organization))
@@ -155,6 +295,27 @@ func (s *workspaces) List(ctx context.Context, organization string, options Work
 	return wl, nil
 }
 
+// ListAll lists the workspaces within an organization across all pages,
+// starting at the page given in the options. The page size of the options
+// is used for every request.
+func (s *workspaces) ListAll(ctx context.Context, organization string, options WorkspaceListOptions) ([]*Workspace, error) {
+	var workspaces []*Workspace
+	err := listPages(options.PageNumber, func(pageNumber int) (*Pagination, bool, error) {
+		options.PageNumber = pageNumber
+		wl, err := s.List(ctx, organization, options)
+		if err != nil {
+			return nil, false, err
+		}
+		workspaces = append(workspaces, wl.Items...)
+		return wl.Pagination, false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return workspaces, nil
+}
+
 // WorkspaceCreateOptions represents the options for creating a new workspace.
 type WorkspaceCreateOptions struct {
 	// For internal use only!
@@ -163,12 +324,28 @@ type WorkspaceCreateOptions struct {
 	// Whether to automatically apply changes when a Terraform plan is successful.
 	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
 
+	// How long the workspace may be inactive before it is automatically
+	// destroyed, in days or hours (e.g. "14d" or "12h").
+	AutoDestroyActivityDuration *string `jsonapi:"attr,auto-destroy-activity-duration,omitempty"`
+
+	// Whether the workspace inherits the auto destroy settings of its
+	// project instead of using its own.
+	InheritsProjectAutoDestroy *bool `jsonapi:"attr,inherits-project-auto-destroy,omitempty"`
+
+	// Which execution mode to use: remote, local or agent. When set to agent,
+	// an agent pool must also be specified.
+	ExecutionMode *ExecutionModeType `jsonapi:"attr,execution-mode,omitempty"`
+
 	// Whether to filter runs based on the changed files in a VCS push. If
 	// enabled, the working directory and trigger prefixes describe a set of
 	// paths which must contain changes for a VCS push to trigger a run. If
 	// disabled, any push will trigger a run.
 	FileTriggersEnabled *bool `jsonapi:"attr,file-triggers-enabled,omitempty"`
 
+	// Whether the state of this workspace is accessible by all the other
+	// workspaces within the organization.
+	GlobalRemoteState *bool `jsonapi:"attr,global-remote-state,omitempty"`
+
 	// The legacy TFE environment to use as the source of the migration, in the
 	// form organization/environment. Omit this unless you are migrating a legacy
 	// environment.
@@ -186,6 +363,16 @@ type WorkspaceCreateOptions struct {
 	// a webhook will not be queued until at least one run is manually queued.
 	QueueAllRuns *bool `jsonapi:"attr,queue-all-runs,omitempty"`
 
+	// A friendly name for the application or client creating this workspace.
+	// If set, this will be displayed on the workspace as "Created via
+	// <SOURCE NAME>".
+	SourceName *string `jsonapi:"attr,source-name,omitempty"`
+
+	// A URL for the application or client creating this workspace. This can
+	// be the URL of a related resource in another app, or a link to
+	// documentation or other info about the client.
+	SourceURL *string `jsonapi:"attr,source-url,omitempty"`
+
 	// The version of Terraform to use for this workspace. Upon creating a
 	// workspace, the latest version is selected unless otherwise specified.
 	TerraformVersion *string `jsonapi:"attr,terraform-version,omitempty"`
@@ -203,6 +390,14 @@ type WorkspaceCreateOptions struct {
 	// root of your repository and is typically set to a subdirectory matching the
 	// environment when multiple environments exist within the same repository.
 	WorkingDirectory *string `jsonapi:"attr,working-directory,omitempty"`
+
+	// The agent pool to run the workspace on. Only used when the execution
+	// mode is agent.
+	AgentPool *AgentPool `jsonapi:"relation,agent-pool,omitempty"`
+
+	// The project to create the workspace in. When omitted, the default
+	// project of the organization is used.
+	Project *Project `jsonapi:"relation,project,omitempty"`
 }
 
 // VCSRepoOptions represents the configuration options of a VCS integration.
@@ -213,22 +408,66 @@ type VCSRepoOptions struct {
 	OAuthTokenID      *string `json:"oauth-token-id,omitempty"`
 }
 
-func (o WorkspaceCreateOptions) valid() error {
+// Validate checks the workspace create options for errors, without making an
+// API request.
+func (o WorkspaceCreateOptions) Validate() error {
 	if !validString(o.Name) {
-		return errors.New("name is required")
+		return validationError("name is required")
 	}
 	if !validStringID(o.Name) {
-		return errors.New("invalid value for name")
+		return validationError("invalid value for name")
+	}
+	if o.Project != nil && !validStringID(&o.Project.ID) {
+		return validationError("invalid value for project ID")
+	}
+	if err := validWorkspaceExecutionMode(o.ExecutionMode, o.AgentPool); err != nil {
+		return err
+	}
+	if o.AgentPool != nil && o.ExecutionMode == nil {
+		return validationError("agent pool can only be set when the execution mode is agent")
+	}
+	if o.ExecutionMode != nil && *o.ExecutionMode == ExecutionModeAgent && o.AgentPool == nil {
+		return validationError("agent pool is required when the execution mode is agent")
+	}
+	return validWorkspaceAutoDestroy(o.AutoDestroyActivityDuration, o.InheritsProjectAutoDestroy)
+}
+
+// validWorkspaceExecutionMode validates the execution mode and agent pool of
+// a workspace. An agent pool can only be used with the agent execution mode.
+// A nil mode leaves the execution mode as it is, so moving a workspace that
+// is already in agent mode to another pool doesn't need one.
+func validWorkspaceExecutionMode(mode *ExecutionModeType, pool *AgentPool) error {
+	if mode != nil && !validExecutionMode(*mode) {
+		return validationError("invalid value for execution mode")
+	}
+	if pool != nil && !validStringID(&pool.ID) {
+		return validationError("invalid value for agent pool ID")
+	}
+	if pool != nil && mode != nil && *mode != ExecutionModeAgent {
+		return validationError("agent pool can only be set when the execution mode is agent")
+	}
+	return nil
+}
+
+// validWorkspaceAutoDestroy validates the auto destroy settings of a
+// workspace.
+func validWorkspaceAutoDestroy(duration *string, inherits *bool) error {
+	if duration != nil && !validActivityDuration(duration) {
+		return validationError("invalid value for auto destroy activity duration")
+	}
+	if duration != nil && inherits != nil && *inherits {
+		return validationError("auto destroy activity duration can not be set when inheriting the project settings")
 	}
 	return nil
 }
 
 // Create is used to create a new workspace.
 func (s *workspaces) Create(ctx context.Context, organization string, options WorkspaceCreateOptions) (*Workspace, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -252,8 +491,21 @@ func (s *workspaces) Create(ctx context.Context, organization string, options Wo
 
 // Read a workspace by its name.
 func (s *workspaces) Read(ctx context.Context, organization, workspace string) (*Workspace, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	return s.ReadWithOptions(ctx, organization, workspace, WorkspaceReadOptions{})
+}
+
+// WorkspaceReadOptions represents the options for reading a workspace.
+type WorkspaceReadOptions struct {
+	// A comma separated list of related resources to include in the
+	// response, e.g. "current-run,organization".
+	Include string `url:"include,omitempty"`
+}
+
+// ReadWithOptions reads a workspace by its name using the given options.
+func (s *workspaces) ReadWithOptions(ctx context.Context, organization, workspace string, options WorkspaceReadOptions) (*Workspace, error) {
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 	if !validStringID(&workspace) {
 		return nil, errors.New("invalid value for workspace")
@@ -264,7 +516,7 @@ func (s *workspaces) Read(ctx context.Context, organization, workspace string) (
 		url.QueryEscape(organization),
 		url.QueryEscape(workspace),
 	)
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -280,12 +532,17 @@ func (s *workspaces) Read(ctx context.Context, organization, workspace string) (
 
 // ReadByID reads a workspace by its ID.
 func (s *workspaces) ReadByID(ctx context.Context, workspaceID string) (*Workspace, error) {
+	return s.ReadByIDWithOptions(ctx, workspaceID, WorkspaceReadOptions{})
+}
+
+// ReadByIDWithOptions reads a workspace by its ID using the given options.
+func (s *workspaces) ReadByIDWithOptions(ctx context.Context, workspaceID string, options WorkspaceReadOptions) (*Workspace, error) {
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
 
 	u := fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("GET", u, nil)
+	req, err := s.client.newRequest("GET", u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -299,6 +556,44 @@ func (s *workspaces) ReadByID(ctx context.Context, workspaceID string) (*Workspa
 	return w, nil
 }
 
+// ReadByIDs concurrently reads the workspaces with the given IDs and returns
+// them keyed by their ID. When some of the workspaces could not be read, the
+// workspaces that were read are returned together with a *BatchError holding
+// the error of each workspace that failed, which matches the context error
+// when the context was canceled before all workspaces were read.
+func (s *workspaces) ReadByIDs(ctx context.Context, workspaceIDs []string, options BatchReadOptions) (map[string]*Workspace, error) {
+	var mu sync.Mutex
+	result := make(map[string]*Workspace, len(workspaceIDs))
+
+	err := readByIDs(ctx, workspaceIDs, options, func(ctx context.Context, id string) error {
+		w, err := s.ReadByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		result[id] = w
+		mu.Unlock()
+
+		return nil
+	})
+
+	return result, err
+}
+
+// Exists reports whether a workspace with the given name exists. A workspace
+// that can not be found results in false, any other error is returned.
+func (s *workspaces) Exists(ctx context.Context, organization, workspace string) (bool, error) {
+	_, err := s.Read(ctx, organization, workspace)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // WorkspaceUpdateOptions represents the options for updating a workspace.
 type WorkspaceUpdateOptions struct {
 	// For internal use only!
@@ -307,18 +602,34 @@ type WorkspaceUpdateOptions struct {
 	// Whether to automatically apply changes when a Terraform plan is successful.
 	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
 
+	// How long the workspace may be inactive before it is automatically
+	// destroyed, in days or hours (e.g. "14d" or "12h").
+	AutoDestroyActivityDuration *string `jsonapi:"attr,auto-destroy-activity-duration,omitempty"`
+
+	// Whether the workspace inherits the auto destroy settings of its
+	// project instead of using its own.
+	InheritsProjectAutoDestroy *bool `jsonapi:"attr,inherits-project-auto-destroy,omitempty"`
+
 	// A new name for the workspace, which can only include letters, numbers, -,
 	// and _. This will be used as an identifier and must be unique in the
 	// organization. Warning: Changing a workspace's name changes its URL in the
 	// API and UI.
 	Name *string `jsonapi:"attr,name,omitempty"`
 
+	// Which execution mode to use: remote, local or agent. When set to agent,
+	// an agent pool must also be specified.
+	ExecutionMode *ExecutionModeType `jsonapi:"attr,execution-mode,omitempty"`
+
 	// Whether to filter runs based on the changed files in a VCS push. If
 	// enabled, the working directory and trigger prefixes describe a set of
 	// paths which must contain changes for a VCS push to trigger a run. If
 	// disabled, any push will trigger a run.
 	FileTriggersEnabled *bool `jsonapi:"attr,file-triggers-enabled,omitempty"`
 
+	// Whether the state of this workspace is accessible by all the other
+	// workspaces within the organization.
+	GlobalRemoteState *bool `jsonapi:"attr,global-remote-state,omitempty"`
+
 	// Whether the workspace will use remote or local execution mode.
 	Operations *bool `jsonapi:"attr,operations,omitempty"`
 
@@ -326,6 +637,16 @@ type WorkspaceUpdateOptions struct {
 	// a webhook will not be queued until at least one run is manually queued.
 	QueueAllRuns *bool `jsonapi:"attr,queue-all-runs,omitempty"`
 
+	// A friendly name for the application or client creating this workspace.
+	// If set, this will be displayed on the workspace as "Created via
+	// <SOURCE NAME>".
+	SourceName *string `jsonapi:"attr,source-name,omitempty"`
+
+	// A URL for the application or client creating this workspace. This can
+	// be the URL of a related resource in another app, or a link to
+	// documentation or other info about the client.
+	SourceURL *string `jsonapi:"attr,source-url,omitempty"`
+
 	// The version of Terraform to use for this workspace.
 	TerraformVersion *string `jsonapi:"attr,terraform-version,omitempty"`
 
@@ -345,16 +666,41 @@ type WorkspaceUpdateOptions struct {
 	// the environment when multiple environments exist within the same
 	// repository.
 	WorkingDirectory *string `jsonapi:"attr,working-directory,omitempty"`
+
+	// The agent pool to run the workspace on. Can not be set together with
+	// the local or remote execution mode. Switching the execution mode to
+	// local or remote detaches the current agent pool in the same request.
+	AgentPool *AgentPool `jsonapi:"relation,agent-pool,omitempty"`
+
+	// The project to move the workspace to. When omitted, the workspace
+	// stays in its current project.
+	Project *Project `jsonapi:"relation,project,omitempty"`
+}
+
+// Validate checks the workspace update options for errors, without making an
+// API request.
+func (o WorkspaceUpdateOptions) Validate() error {
+	if o.Project != nil && !validStringID(&o.Project.ID) {
+		return validationError("invalid value for project ID")
+	}
+	if err := validWorkspaceExecutionMode(o.ExecutionMode, o.AgentPool); err != nil {
+		return err
+	}
+	return validWorkspaceAutoDestroy(o.AutoDestroyActivityDuration, o.InheritsProjectAutoDestroy)
 }
 
 // Update settings of an existing workspace.
 func (s *workspaces) Update(ctx context.Context, organization, workspace string, options WorkspaceUpdateOptions) (*Workspace, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 	if !validStringID(&workspace) {
 		return nil, errors.New("invalid value for workspace")
 	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
@@ -364,7 +710,7 @@ func (s *workspaces) Update(ctx context.Context, organization, workspace string,
 		url.QueryEscape(organization),
 		url.QueryEscape(workspace),
 	)
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.newUpdateRequest(u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -378,17 +724,221 @@ func (s *workspaces) Update(ctx context.Context, organization, workspace string,
 	return w, nil
 }
 
+// Mutate reads a workspace, applies the given mutation to a copy of it and
+// updates only the settings that were changed by the mutation, leaving all
+// other settings as they are. No request is made when nothing was changed.
+// Only the settings that can be changed with UpdateByID are considered;
+// setting VCSRepo to nil detaches the VCS repository. An agent pool is only
+// detached together with switching to the local or remote execution mode,
+// in the same request.
+func (s *workspaces) Mutate(ctx context.Context, workspaceID string, mutate func(*Workspace)) (*Workspace, error) {
+	if mutate == nil {
+		return nil, errors.New("mutate is required")
+	}
+
+	current, err := s.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := copyWorkspace(current)
+	mutate(desired)
+
+	options, err := workspaceChanges(current, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	w := current
+	if options != nil {
+		w, err = s.UpdateByID(ctx, workspaceID, *options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if current.VCSRepo != nil && desired.VCSRepo == nil {
+		w, err = s.RemoveVCSConnectionByID(ctx, workspaceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// copyWorkspace returns a copy of the workspace that can be changed without
+// affecting the original.
+func copyWorkspace(w *Workspace) *Workspace {
+	cp := *w
+	cp.TriggerPrefixes = append([]string(nil), w.TriggerPrefixes...)
+	if w.VCSRepo != nil {
+		vcs := *w.VCSRepo
+		cp.VCSRepo = &vcs
+	}
+	if w.AgentPool != nil {
+		cp.AgentPool = &AgentPool{ID: w.AgentPool.ID}
+	}
+	if w.Project != nil {
+		cp.Project = &Project{ID: w.Project.ID}
+	}
+	return &cp
+}
+
+// workspaceChanges returns the update options for the settings that differ
+// between the current and desired state of a workspace, or nil when there
+// are no changes that need an update.
+func workspaceChanges(current, desired *Workspace) (*WorkspaceUpdateOptions, error) {
+	options := WorkspaceUpdateOptions{}
+	changed := false
+
+	if desired.AutoApply != current.AutoApply {
+		options.AutoApply = Bool(desired.AutoApply)
+		changed = true
+	}
+	if desired.AutoDestroyActivityDuration != current.AutoDestroyActivityDuration {
+		options.AutoDestroyActivityDuration = String(desired.AutoDestroyActivityDuration)
+		changed = true
+	}
+	if desired.InheritsProjectAutoDestroy != current.InheritsProjectAutoDestroy {
+		options.InheritsProjectAutoDestroy = Bool(desired.InheritsProjectAutoDestroy)
+		changed = true
+	}
+	if desired.Name != current.Name {
+		options.Name = String(desired.Name)
+		changed = true
+	}
+	if desired.ExecutionMode != current.ExecutionMode {
+		options.ExecutionMode = ExecutionMode(desired.ExecutionMode)
+		changed = true
+	}
+	if desired.FileTriggersEnabled != current.FileTriggersEnabled {
+		options.FileTriggersEnabled = Bool(desired.FileTriggersEnabled)
+		changed = true
+	}
+	if desired.GlobalRemoteState != current.GlobalRemoteState {
+		options.GlobalRemoteState = Bool(desired.GlobalRemoteState)
+		changed = true
+	}
+	if desired.Operations != current.Operations {
+		options.Operations = Bool(desired.Operations)
+		changed = true
+	}
+	if desired.QueueAllRuns != current.QueueAllRuns {
+		options.QueueAllRuns = Bool(desired.QueueAllRuns)
+		changed = true
+	}
+	if desired.SourceName != current.SourceName {
+		options.SourceName = String(desired.SourceName)
+		changed = true
+	}
+	if desired.SourceURL != current.SourceURL {
+		options.SourceURL = String(desired.SourceURL)
+		changed = true
+	}
+	if desired.TerraformVersion != current.TerraformVersion {
+		options.TerraformVersion = String(desired.TerraformVersion)
+		changed = true
+	}
+	if (len(desired.TriggerPrefixes) > 0 || len(current.TriggerPrefixes) > 0) &&
+		!reflect.DeepEqual(desired.TriggerPrefixes, current.TriggerPrefixes) {
+		if len(desired.TriggerPrefixes) == 0 {
+			return nil, errors.New("trigger prefixes can not be cleared")
+		}
+		options.TriggerPrefixes = desired.TriggerPrefixes
+		changed = true
+	}
+	if desired.VCSRepo != nil && !reflect.DeepEqual(desired.VCSRepo, current.VCSRepo) {
+		options.VCSRepo = &VCSRepoOptions{
+			Branch:            String(desired.VCSRepo.Branch),
+			Identifier:        String(desired.VCSRepo.Identifier),
+			IngressSubmodules: Bool(desired.VCSRepo.IngressSubmodules),
+			OAuthTokenID:      String(desired.VCSRepo.OAuthTokenID),
+		}
+		changed = true
+	}
+	if desired.WorkingDirectory != current.WorkingDirectory {
+		options.WorkingDirectory = String(desired.WorkingDirectory)
+		changed = true
+	}
+	if desired.AgentPool != nil && (current.AgentPool == nil || desired.AgentPool.ID != current.AgentPool.ID) {
+		options.AgentPool = desired.AgentPool
+		changed = true
+	}
+	if desired.Project != nil && (current.Project == nil || desired.Project.ID != current.Project.ID) {
+		options.Project = desired.Project
+		changed = true
+	}
+
+	// Detaching the agent pool requires switching to another execution
+	// mode, which detaches it in the same request.
+	if current.AgentPool != nil && desired.AgentPool == nil && desired.ExecutionMode == ExecutionModeAgent {
+		return nil, errors.New("agent pool is required when the execution mode is agent")
+	}
+
+	// An agent pool can only be set together with the execution mode.
+	if options.AgentPool != nil && options.ExecutionMode == nil {
+		options.ExecutionMode = ExecutionMode(desired.ExecutionMode)
+	}
+
+	if !changed {
+		return nil, nil
+	}
+	return &options, nil
+}
+
+// newUpdateRequest creates the request to update a workspace. When the
+// workspace is switched to the local or remote execution mode, the agent pool
+// is detached in the same request, as an agent pool is only valid in
+// combination with the agent execution mode.
+func (s *workspaces) newUpdateRequest(u string, options *WorkspaceUpdateOptions) (*retryablehttp.Request, error) {
+	if options.ExecutionMode == nil || *options.ExecutionMode == ExecutionModeAgent {
+		return s.client.newRequest("PATCH", u, options)
+	}
+
+	// The options can't express a null relationship, so add it to the
+	// encoded payload.
+	buf := bytes.NewBuffer(nil)
+	if err := jsonapi.MarshalPayloadWithoutIncluded(buf, options); err != nil {
+		return nil, err
+	}
+
+	var payload struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &payload); err != nil {
+		return nil, err
+	}
+
+	relationships, ok := payload.Data["relationships"].(map[string]interface{})
+	if !ok {
+		relationships = make(map[string]interface{})
+		payload.Data["relationships"] = relationships
+	}
+	relationships["agent-pool"] = map[string]interface{}{"data": nil}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.newRequest("PATCH", u, json.RawMessage(body))
+}
+
 // UpdateByID updates the settings of an existing workspace.
 func (s *workspaces) UpdateByID(ctx context.Context, workspaceID string, options WorkspaceUpdateOptions) (*Workspace, error) {
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Make sure we don't send a user provided ID.
 	options.ID = ""
 
 	u := fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID))
-	req, err := s.client.newRequest("PATCH", u, &options)
+	req, err := s.newUpdateRequest(u, &options)
 	if err != nil {
 		return nil, err
 	}
@@ -404,8 +954,9 @@ func (s *workspaces) UpdateByID(ctx context.Context, workspaceID string, options
 
 // Delete a workspace by its name.
 func (s *workspaces) Delete(ctx context.Context, organization, workspace string) error {
-	if !validStringID(&organization) {
-		return errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return err
 	}
 	if !validStringID(&workspace) {
 		return errors.New("invalid value for workspace")
@@ -447,8 +998,9 @@ type workspaceRemoveVCSConnectionOptions struct {
 
 // RemoveVCSConnection from a workspace.
 func (s *workspaces) RemoveVCSConnection(ctx context.Context, organization, workspace string) (*Workspace, error) {
-	if !validStringID(&organization) {
-		return nil, errors.New("invalid value for organization")
+	organization, err := s.client.organization(organization)
+	if err != nil {
+		return nil, err
 	}
 	if !validStringID(&workspace) {
 		return nil, errors.New("invalid value for workspace")
@@ -575,12 +1127,14 @@ type WorkspaceAssignSSHKeyOptions struct {
 	SSHKeyID *string `jsonapi:"attr,id"`
 }
 
-func (o WorkspaceAssignSSHKeyOptions) valid() error {
+// Validate checks the workspace assign SSH key options for errors, without
+// making an API request.
+func (o WorkspaceAssignSSHKeyOptions) Validate() error {
 	if !validString(o.SSHKeyID) {
-		return errors.New("SSH key ID is required")
+		return validationError("SSH key ID is required")
 	}
 	if !validStringID(o.SSHKeyID) {
-		return errors.New("invalid value for SSH key ID")
+		return validationError("invalid value for SSH key ID")
 	}
 	return nil
 }
@@ -590,7 +1144,7 @@ func (s *workspaces) AssignSSHKey(ctx context.Context, workspaceID string, optio
 	if !validStringID(&workspaceID) {
 		return nil, errors.New("invalid value for workspace ID")
 	}
-	if err := options.valid(); err != nil {
+	if err := options.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -642,3 +1196,133 @@ func (s *workspaces) UnassignSSHKey(ctx context.Context, workspaceID string) (*W
 
 	return w, nil
 }
+
+// workspaceRemoveAgentPoolOptions represents the options to detach the agent
+// pool from a workspace.
+type workspaceRemoveAgentPoolOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,workspaces"`
+
+	// Must be nil to send a null relationship, which detaches the pool.
+	AgentPool *AgentPool `jsonapi:"relation,agent-pool"`
+}
+
+// RemoveAgentPool detaches the agent pool from a workspace.
+func (s *workspaces) RemoveAgentPool(ctx context.Context, workspaceID string) (*Workspace, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+
+	u := fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("PATCH", u, &workspaceRemoveAgentPoolOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Workspace{}
+	err = s.client.do(ctx, req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// RemoteStateConsumers lists the workspaces allowed to access the state of
+// the given workspace.
+func (s *workspaces) RemoteStateConsumers(ctx context.Context, workspaceID string, options ListOptions) (*WorkspaceList, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+
+	u := fmt.Sprintf("workspaces/%s/relationships/remote-state-consumers", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	wl := &WorkspaceList{}
+	err = s.client.do(ctx, req, wl)
+	if err != nil {
+		return nil, err
+	}
+
+	return wl, nil
+}
+
+// WorkspaceRemoteStateSharingOptions represents the options for configuring
+// which workspaces can access the state of a workspace.
+type WorkspaceRemoteStateSharingOptions struct {
+	// Whether the state is accessible by all the workspaces within the
+	// organization.
+	GlobalRemoteState *bool
+
+	// The IDs of the workspaces allowed to access the state. This replaces
+	// the current set of consumers and can only be used when global remote
+	// state is disabled. An empty list removes all consumers.
+	ConsumerIDs []string
+}
+
+// Validate checks the workspace remote state sharing options for errors,
+// without making an API request.
+func (o WorkspaceRemoteStateSharingOptions) Validate() error {
+	if o.GlobalRemoteState == nil {
+		return validationError("global remote state is required")
+	}
+	if *o.GlobalRemoteState && len(o.ConsumerIDs) > 0 {
+		return validationError("consumers can not be set when global remote state is enabled")
+	}
+	for _, id := range o.ConsumerIDs {
+		if !validStringID(&id) {
+			return validationError("invalid value for consumer ID")
+		}
+	}
+	return nil
+}
+
+// workspaceConsumer is used to reference a remote state consumer.
+type workspaceConsumer struct {
+	ID string `jsonapi:"primary,workspaces"`
+}
+
+// ConfigureRemoteStateSharing sets the global remote state flag and the
+// explicit set of remote state consumers of a workspace. The API rejects
+// consumers while global remote state is enabled, so the flag is always
+// updated first and the consumers are only replaced when it is disabled.
+func (s *workspaces) ConfigureRemoteStateSharing(ctx context.Context, workspaceID string, options WorkspaceRemoteStateSharingOptions) (*Workspace, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	w, err := s.UpdateByID(ctx, workspaceID, WorkspaceUpdateOptions{
+		GlobalRemoteState: options.GlobalRemoteState,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if *options.GlobalRemoteState {
+		return w, nil
+	}
+
+	consumers := []*workspaceConsumer{}
+	for _, id := range options.ConsumerIDs {
+		consumers = append(consumers, &workspaceConsumer{ID: id})
+	}
+
+	u := fmt.Sprintf("workspaces/%s/relationships/remote-state-consumers", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("PATCH", u, consumers)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
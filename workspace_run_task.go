@@ -0,0 +1,264 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Compile-time proof of interface implementation.
+var _ WorkspaceRunTasks = (*workspaceRunTasks)(nil)
+
+// WorkspaceRunTasks describes all the workspace run task related methods
+// that the Terraform Enterprise API supports.
+//
+// TFE API docs:
+// https://www.terraform.io/docs/cloud/api/run-tasks.html
+type WorkspaceRunTasks interface {
+	// List all the run tasks attached to the given workspace.
+	List(ctx context.Context, workspaceID string, options WorkspaceRunTaskListOptions) (*WorkspaceRunTaskList, error)
+
+	// Create attaches a run task to the given workspace.
+	Create(ctx context.Context, workspaceID string, options WorkspaceRunTaskCreateOptions) (*WorkspaceRunTask, error)
+
+	// Read a workspace run task by its ID.
+	Read(ctx context.Context, workspaceID string, workspaceTaskID string) (*WorkspaceRunTask, error)
+
+	// Update a workspace run task by its ID.
+	Update(ctx context.Context, workspaceID string, workspaceTaskID string, options WorkspaceRunTaskUpdateOptions) (*WorkspaceRunTask, error)
+
+	// Delete detaches a run task from a workspace by its ID.
+	Delete(ctx context.Context, workspaceID string, workspaceTaskID string) error
+}
+
+// workspaceRunTasks implements WorkspaceRunTasks.
+type workspaceRunTasks struct {
+	client *Client
+}
+
+// WorkspaceRunTaskList represents a list of workspace run tasks.
+type WorkspaceRunTaskList struct {
+	*Pagination
+	Items []*WorkspaceRunTask
+}
+
+// WorkspaceRunTask represents a run task attached to a workspace.
+type WorkspaceRunTask struct {
+	ID               string               `jsonapi:"primary,workspace-tasks"`
+	EnforcementLevel TaskEnforcementLevel `jsonapi:"attr,enforcement-level"`
+	Stage            Stage                `jsonapi:"attr,stage"`
+
+	// Relations
+	RunTask   *RunTask   `jsonapi:"relation,task"`
+	Workspace *Workspace `jsonapi:"relation,workspace"`
+}
+
+// WorkspaceRunTaskListOptions represents the options for listing workspace
+// run tasks.
+type WorkspaceRunTaskListOptions struct {
+	ListOptions
+}
+
+// List all the run tasks attached to the given workspace.
+func (s *workspaceRunTasks) List(ctx context.Context, workspaceID string, options WorkspaceRunTaskListOptions) (*WorkspaceRunTaskList, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+
+	u := fmt.Sprintf("workspaces/%s/tasks", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	wrtl := &WorkspaceRunTaskList{}
+	err = s.client.do(ctx, req, wrtl)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrtl, nil
+}
+
+// WorkspaceRunTaskCreateOptions represents the options for attaching a run
+// task to a workspace.
+type WorkspaceRunTaskCreateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,workspace-tasks"`
+
+	// The enforcement level of the run task.
+	EnforcementLevel *TaskEnforcementLevel `jsonapi:"attr,enforcement-level"`
+
+	// The stage the run task is executed in, which defaults to post_plan.
+	Stage *Stage `jsonapi:"attr,stage,omitempty"`
+
+	// The run task to attach to the workspace.
+	RunTask *RunTask `jsonapi:"relation,task"`
+}
+
+// Validate checks the workspace run task create options for errors, without
+// making an API request.
+func (o WorkspaceRunTaskCreateOptions) Validate() error {
+	if o.EnforcementLevel == nil {
+		return validationError("enforcement level is required")
+	}
+	if !validTaskEnforcementLevel(*o.EnforcementLevel) {
+		return validationError("invalid value for enforcement level")
+	}
+	if o.Stage != nil && !validStage(*o.Stage) {
+		return validationError("invalid value for stage")
+	}
+	if o.RunTask == nil {
+		return validationError("run task is required")
+	}
+	if !validStringID(&o.RunTask.ID) {
+		return validationError("invalid value for run task ID")
+	}
+
+	stage := PostPlan
+	if o.Stage != nil {
+		stage = *o.Stage
+	}
+	return validStageEnforcement(stage, *o.EnforcementLevel)
+}
+
+// Create attaches a run task to the given workspace.
+func (s *workspaceRunTasks) Create(ctx context.Context, workspaceID string, options WorkspaceRunTaskCreateOptions) (*WorkspaceRunTask, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf("workspaces/%s/tasks", url.QueryEscape(workspaceID))
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	wrt := &WorkspaceRunTask{}
+	err = s.client.do(ctx, req, wrt)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrt, nil
+}
+
+// Read a workspace run task by its ID.
+func (s *workspaceRunTasks) Read(ctx context.Context, workspaceID string, workspaceTaskID string) (*WorkspaceRunTask, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if !validStringID(&workspaceTaskID) {
+		return nil, errors.New("invalid value for workspace run task ID")
+	}
+
+	u := fmt.Sprintf(
+		"workspaces/%s/tasks/%s",
+		url.QueryEscape(workspaceID),
+		url.QueryEscape(workspaceTaskID),
+	)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	wrt := &WorkspaceRunTask{}
+	err = s.client.do(ctx, req, wrt)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrt, nil
+}
+
+// WorkspaceRunTaskUpdateOptions represents the options for updating a
+// workspace run task.
+type WorkspaceRunTaskUpdateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,workspace-tasks"`
+
+	// A new enforcement level of the run task.
+	EnforcementLevel *TaskEnforcementLevel `jsonapi:"attr,enforcement-level,omitempty"`
+
+	// A new stage the run task is executed in.
+	Stage *Stage `jsonapi:"attr,stage,omitempty"`
+}
+
+// Validate checks the workspace run task update options for errors, without
+// making an API request. The combination of the stage and the enforcement
+// level can only be checked when both are given.
+func (o WorkspaceRunTaskUpdateOptions) Validate() error {
+	if o.EnforcementLevel != nil && !validTaskEnforcementLevel(*o.EnforcementLevel) {
+		return validationError("invalid value for enforcement level")
+	}
+	if o.Stage != nil && !validStage(*o.Stage) {
+		return validationError("invalid value for stage")
+	}
+	if o.EnforcementLevel != nil && o.Stage != nil {
+		return validStageEnforcement(*o.Stage, *o.EnforcementLevel)
+	}
+	return nil
+}
+
+// Update a workspace run task by its ID.
+func (s *workspaceRunTasks) Update(ctx context.Context, workspaceID string, workspaceTaskID string, options WorkspaceRunTaskUpdateOptions) (*WorkspaceRunTask, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if !validStringID(&workspaceTaskID) {
+		return nil, errors.New("invalid value for workspace run task ID")
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	u := fmt.Sprintf(
+		"workspaces/%s/tasks/%s",
+		url.QueryEscape(workspaceID),
+		url.QueryEscape(workspaceTaskID),
+	)
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	wrt := &WorkspaceRunTask{}
+	err = s.client.do(ctx, req, wrt)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrt, nil
+}
+
+// Delete detaches a run task from a workspace by its ID.
+func (s *workspaceRunTasks) Delete(ctx context.Context, workspaceID string, workspaceTaskID string) error {
+	if !validStringID(&workspaceID) {
+		return errors.New("invalid value for workspace ID")
+	}
+	if !validStringID(&workspaceTaskID) {
+		return errors.New("invalid value for workspace run task ID")
+	}
+
+	u := fmt.Sprintf(
+		"workspaces/%s/tasks/%s",
+		url.QueryEscape(workspaceID),
+		url.QueryEscape(workspaceTaskID),
+	)
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
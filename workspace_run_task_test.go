@@ -0,0 +1,123 @@
+package tfe
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceRunTasks(t *testing.T) {
+	var requests []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{
+				"data": [
+					{"id": "wstask-1", "type": "workspace-tasks", "attributes": {"enforcement-level": "mandatory", "stage": "post_plan"}, "relationships": {"task": {"data": {"id": "task-1", "type": "tasks"}}}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 1}}
+			}`))
+		case "POST":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Contains(t, string(body), `"enforcement-level":"advisory"`)
+			assert.Contains(t, string(body), `"stage":"pre_apply"`)
+			assert.Contains(t, string(body), `"task":{"data":{"type":"tasks","id":"task-2"}}`)
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data": {"id": "wstask-2", "type": "workspace-tasks", "attributes": {"enforcement-level": "advisory", "stage": "pre_apply"}, "relationships": {"task": {"data": {"id": "task-2", "type": "tasks"}}}}}`))
+		case "PATCH":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Contains(t, string(body), `"enforcement-level":"mandatory"`)
+			w.Write([]byte(`{"data": {"id": "wstask-2", "type": "workspace-tasks", "attributes": {"enforcement-level": "mandatory", "stage": "pre_apply"}}}`))
+		case "DELETE":
+			w.WriteHeader(204)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("list", func(t *testing.T) {
+		wrtl, err := client.WorkspaceRunTasks.List(ctx, "ws-123", WorkspaceRunTaskListOptions{})
+		require.NoError(t, err)
+		require.Len(t, wrtl.Items, 1)
+		assert.Equal(t, Mandatory, wrtl.Items[0].EnforcementLevel)
+		assert.Equal(t, PostPlan, wrtl.Items[0].Stage)
+		assert.Equal(t, "task-1", wrtl.Items[0].RunTask.ID)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		level, stage := Advisory, PreApply
+		wrt, err := client.WorkspaceRunTasks.Create(ctx, "ws-123", WorkspaceRunTaskCreateOptions{
+			EnforcementLevel: &level,
+			Stage:            &stage,
+			RunTask:          &RunTask{ID: "task-2"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "wstask-2", wrt.ID)
+		assert.Equal(t, PreApply, wrt.Stage)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		level := Mandatory
+		wrt, err := client.WorkspaceRunTasks.Update(ctx, "ws-123", "wstask-2", WorkspaceRunTaskUpdateOptions{
+			EnforcementLevel: &level,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, Mandatory, wrt.EnforcementLevel)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		requests = nil
+		err := client.WorkspaceRunTasks.Delete(ctx, "ws-123", "wstask-2")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"DELETE /api/tfe/v2/workspaces/ws-123/tasks/wstask-2"}, requests)
+	})
+
+	t.Run("with an invalid workspace run task ID", func(t *testing.T) {
+		wrt, err := client.WorkspaceRunTasks.Read(ctx, "ws-123", badIdentifier)
+		assert.Nil(t, wrt)
+		assert.EqualError(t, err, "invalid value for workspace run task ID")
+	})
+}
+
+func TestWorkspaceRunTaskOptionsValidate(t *testing.T) {
+	stage := func(v Stage) *Stage { return &v }
+	level := func(v TaskEnforcementLevel) *TaskEnforcementLevel { return &v }
+
+	t.Run("create", func(t *testing.T) {
+		cases := []struct {
+			options WorkspaceRunTaskCreateOptions
+			err     string
+		}{
+			{WorkspaceRunTaskCreateOptions{EnforcementLevel: level(Mandatory), Stage: stage(PostPlan), RunTask: &RunTask{ID: "task-1"}}, ""},
+			{WorkspaceRunTaskCreateOptions{EnforcementLevel: level(Advisory), Stage: stage(PostApply), RunTask: &RunTask{ID: "task-1"}}, ""},
+			{WorkspaceRunTaskCreateOptions{EnforcementLevel: level(Mandatory), RunTask: &RunTask{ID: "task-1"}}, ""},
+			{WorkspaceRunTaskCreateOptions{Stage: stage(PostPlan), RunTask: &RunTask{ID: "task-1"}}, "enforcement level is required"},
+			{WorkspaceRunTaskCreateOptions{EnforcementLevel: level("blocking"), RunTask: &RunTask{ID: "task-1"}}, "invalid value for enforcement level"},
+			{WorkspaceRunTaskCreateOptions{EnforcementLevel: level(Advisory), Stage: stage("post_destroy"), RunTask: &RunTask{ID: "task-1"}}, "invalid value for stage"},
+			{WorkspaceRunTaskCreateOptions{EnforcementLevel: level(Advisory)}, "run task is required"},
+			{WorkspaceRunTaskCreateOptions{EnforcementLevel: level(Mandatory), Stage: stage(PostApply), RunTask: &RunTask{ID: "task-1"}}, "enforcement level can not be mandatory in the post_apply stage"},
+		}
+		for _, c := range cases {
+			err := c.options.Validate()
+			if c.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, c.err)
+			}
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		assert.NoError(t, WorkspaceRunTaskUpdateOptions{Stage: stage(PostApply)}.Validate())
+		assert.EqualError(t, WorkspaceRunTaskUpdateOptions{
+			EnforcementLevel: level(Mandatory),
+			Stage:            stage(PostApply),
+		}.Validate(), "enforcement level can not be mandatory in the post_apply stage")
+	})
+}
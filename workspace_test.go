@@ -2,6 +2,11 @@ package tfe
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -76,6 +81,134 @@ func TestWorkspacesList(t *testing.T) {
 	})
 }
 
+func TestWorkspacesListAll(t *testing.T) {
+	var pages []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "50", r.URL.Query().Get("page[size]"))
+		page := r.URL.Query().Get("page[number]")
+		pages = append(pages, page)
+
+		switch r.URL.Path {
+		case "/api/tfe/v2/organizations/acme/workspaces":
+			switch page {
+			case "":
+				w.Write([]byte(`{"data": [{"id": "ws-1", "type": "workspaces"}, {"id": "ws-2", "type": "workspaces"}],
+					"meta": {"pagination": {"current-page": 1, "next-page": 2, "total-pages": 2, "total-count": 3}}}`))
+			case "2":
+				w.Write([]byte(`{"data": [{"id": "ws-3", "type": "workspaces"}],
+					"meta": {"pagination": {"current-page": 2, "prev-page": 1, "total-pages": 2, "total-count": 3}}}`))
+			}
+		case "/api/tfe/v2/organizations/looping/workspaces":
+			// A broken next page link pointing back at the current page.
+			w.Write([]byte(`{"data": [{"id": "ws-1", "type": "workspaces"}],
+				"meta": {"pagination": {"current-page": 1, "next-page": 1}}}`))
+		}
+	})
+
+	ctx := context.Background()
+	options := WorkspaceListOptions{ListOptions: ListOptions{PageSize: 50}}
+
+	t.Run("with multiple pages", func(t *testing.T) {
+		pages = nil
+		ws, err := client.Workspaces.ListAll(ctx, "acme", options)
+		require.NoError(t, err)
+		require.Len(t, ws, 3)
+		assert.Equal(t, "ws-3", ws[2].ID)
+		assert.Equal(t, []string{"", "2"}, pages)
+	})
+
+	t.Run("when the next page does not advance", func(t *testing.T) {
+		pages = nil
+		ws, err := client.Workspaces.ListAll(ctx, "looping", options)
+		require.NoError(t, err)
+		assert.Len(t, ws, 1)
+		assert.Equal(t, []string{""}, pages)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		ws, err := client.Workspaces.ListAll(ctx, badIdentifier, options)
+		assert.Nil(t, ws)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
+func TestWorkspacesListSortAndFilter(t *testing.T) {
+	var query url.Values
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/organizations/acme/workspaces", r.URL.Path)
+		query = r.URL.Query()
+		w.Write([]byte(`{"data": [{"id": "ws-123", "type": "workspaces", "attributes": {"name": "network", "resource-count": 42, "tag-names": ["app:billing", "env:prod"]}}],
+			"meta": {"pagination": {"current-page": 1}}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("with a current run status filter and sort", func(t *testing.T) {
+		_, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{
+			CurrentRunStatus: RunStatusFilter(RunErrored),
+			Sort:             String("-current-run.created-at"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "errored", query.Get("filter[current-run][status]"))
+		assert.Equal(t, "-current-run.created-at", query.Get("sort"))
+	})
+
+	t.Run("when sorting on the resource count", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{
+			Sort: String("-resource-count"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "-resource-count", query.Get("sort"))
+		require.Len(t, wl.Items, 1)
+		assert.Equal(t, 42, wl.Items[0].ResourceCount)
+	})
+
+	t.Run("with a name and tags search", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{
+			Search: String("net"),
+			Tags:   String("app:billing,env:prod"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "net", query.Get("search[name]"))
+		assert.Equal(t, "app:billing,env:prod", query.Get("search[tags]"))
+		require.Len(t, wl.Items, 1)
+		assert.Equal(t, []string{"app:billing", "env:prod"}, wl.Items[0].TagNames)
+	})
+
+	t.Run("without any options", func(t *testing.T) {
+		_, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, query, "filter[current-run][status]")
+		assert.NotContains(t, query, "search[tags]")
+		assert.NotContains(t, query, "sort")
+	})
+
+	t.Run("with empty tags", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{
+			Tags: String(""),
+		})
+		assert.Nil(t, wl)
+		assert.EqualError(t, err, "invalid value for tags")
+	})
+
+	t.Run("with an invalid sort key", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{
+			Sort: String("-updated-at"),
+		})
+		assert.Nil(t, wl)
+		assert.EqualError(t, err, "invalid value for sort")
+	})
+
+	t.Run("with an empty current run status", func(t *testing.T) {
+		empty := RunStatus("")
+		wl, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{
+			CurrentRunStatus: &empty,
+		})
+		assert.Nil(t, wl)
+		assert.EqualError(t, err, "invalid value for current run status")
+	})
+}
+
 func TestWorkspacesCreate(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -89,6 +222,8 @@ func TestWorkspacesCreate(t *testing.T) {
 			AutoApply:        Bool(true),
 			Operations:       Bool(true),
 			QueueAllRuns:     Bool(true),
+			SourceName:       String("my-app"),
+			SourceURL:        String("http://my-app-hostname.io"),
 			TerraformVersion: String("0.12.19"),
 			WorkingDirectory: String("bar/"),
 		}
@@ -109,17 +244,61 @@ func TestWorkspacesCreate(t *testing.T) {
 			assert.Equal(t, *options.AutoApply, item.AutoApply)
 			assert.Equal(t, *options.Operations, item.Operations)
 			assert.Equal(t, *options.QueueAllRuns, item.QueueAllRuns)
+			assert.Equal(t, *options.SourceName, item.SourceName)
+			assert.Equal(t, *options.SourceURL, item.SourceURL)
 			assert.Equal(t, *options.TerraformVersion, item.TerraformVersion)
 			assert.Equal(t, *options.WorkingDirectory, item.WorkingDirectory)
 		}
 	})
 
+	t.Run("when the name is already taken", func(t *testing.T) {
+		w, err := client.Workspaces.Create(ctx, orgTest.Name, WorkspaceCreateOptions{
+			Name: String("foo"),
+		})
+		assert.Nil(t, w)
+		assert.Equal(t, ErrResourceAlreadyExists, err)
+	})
+
 	t.Run("when options is missing name", func(t *testing.T) {
 		w, err := client.Workspaces.Create(ctx, "foo", WorkspaceCreateOptions{})
 		assert.Nil(t, w)
 		assert.EqualError(t, err, "name is required")
 	})
 
+	t.Run("in a project", func(t *testing.T) {
+		pTest, pTestCleanup := createProject(t, client, orgTest)
+		defer pTestCleanup()
+
+		w, err := client.Workspaces.Create(ctx, orgTest.Name, WorkspaceCreateOptions{
+			Name:                       String(randomString(t)),
+			InheritsProjectAutoDestroy: Bool(true),
+			Project:                    pTest,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, w.Project)
+		assert.Equal(t, pTest.ID, w.Project.ID)
+		assert.True(t, w.InheritsProjectAutoDestroy)
+	})
+
+	t.Run("with an invalid auto destroy activity duration", func(t *testing.T) {
+		w, err := client.Workspaces.Create(ctx, orgTest.Name, WorkspaceCreateOptions{
+			Name:                        String("foo"),
+			AutoDestroyActivityDuration: String("soon"),
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "invalid value for auto destroy activity duration")
+	})
+
+	t.Run("with an auto destroy activity duration while inheriting the project settings", func(t *testing.T) {
+		w, err := client.Workspaces.Create(ctx, orgTest.Name, WorkspaceCreateOptions{
+			Name:                        String("foo"),
+			AutoDestroyActivityDuration: String("14d"),
+			InheritsProjectAutoDestroy:  Bool(true),
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "auto destroy activity duration can not be set when inheriting the project settings")
+	})
+
 	t.Run("when options has an invalid name", func(t *testing.T) {
 		w, err := client.Workspaces.Create(ctx, "foo", WorkspaceCreateOptions{
 			Name: String(badIdentifier),
@@ -204,6 +383,35 @@ func TestWorkspacesRead(t *testing.T) {
 	})
 }
 
+func TestWorkspacesExists(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+
+	t.Run("when the workspace exists", func(t *testing.T) {
+		exists, err := client.Workspaces.Exists(ctx, orgTest.Name, wTest.Name)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("when the workspace does not exist", func(t *testing.T) {
+		exists, err := client.Workspaces.Exists(ctx, orgTest.Name, "nonexisting")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		exists, err := client.Workspaces.Exists(ctx, badIdentifier, wTest.Name)
+		assert.False(t, exists)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
 func TestWorkspacesReadByID(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -315,6 +523,26 @@ func TestWorkspacesUpdate(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("when moving the workspace to a project", func(t *testing.T) {
+		pTest, pTestCleanup := createProject(t, client, orgTest)
+		defer pTestCleanup()
+
+		w, err := client.Workspaces.Update(ctx, orgTest.Name, wTest.Name, WorkspaceUpdateOptions{
+			Project: pTest,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, w.Project)
+		assert.Equal(t, pTest.ID, w.Project.ID)
+	})
+
+	t.Run("with an invalid project ID", func(t *testing.T) {
+		w, err := client.Workspaces.Update(ctx, orgTest.Name, wTest.Name, WorkspaceUpdateOptions{
+			Project: &Project{ID: badIdentifier},
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "invalid value for project ID")
+	})
+
 	t.Run("when options has an invalid name", func(t *testing.T) {
 		w, err := client.Workspaces.Update(ctx, orgTest.Name, badIdentifier, WorkspaceUpdateOptions{})
 		assert.Nil(t, w)
@@ -499,6 +727,12 @@ func TestWorkspacesLock(t *testing.T) {
 		w, err := client.Workspaces.Lock(ctx, wTest.ID, WorkspaceLockOptions{})
 		require.NoError(t, err)
 		assert.True(t, w.Locked)
+
+		t.Run("the locking user is decoded", func(t *testing.T) {
+			require.NotNil(t, w.LockedBy)
+			assert.NotNil(t, w.LockedBy.User)
+			assert.Nil(t, w.LockedBy.Run)
+		})
 	})
 
 	t.Run("when workspace is already locked", func(t *testing.T) {
@@ -664,3 +898,543 @@ func TestWorkspacesUnassignSSHKey(t *testing.T) {
 		assert.EqualError(t, err, "invalid value for workspace ID")
 	})
 }
+
+func TestWorkspacesSSHKeyRequests(t *testing.T) {
+	var body string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/tfe/v2/workspaces/ws-123/relationships/ssh-key", r.URL.Path)
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.Write([]byte(`{"data": {"id": "ws-123", "type": "workspaces"}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("when assigning an SSH key", func(t *testing.T) {
+		_, err := client.Workspaces.AssignSSHKey(ctx, "ws-123", WorkspaceAssignSSHKeyOptions{
+			SSHKeyID: String("sshkey-123"),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, body, `"id":"sshkey-123"`)
+	})
+
+	t.Run("when unassigning the SSH key", func(t *testing.T) {
+		// The SSH key must be explicitly set to null to unassign it.
+		w, err := client.Workspaces.UnassignSSHKey(ctx, "ws-123")
+		require.NoError(t, err)
+		assert.Nil(t, w.SSHKey)
+		assert.Contains(t, body, `"attributes":{"id":null}`)
+	})
+}
+
+func TestWorkspacesRemoteStateConsumers(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, _ := createWorkspace(t, client, orgTest)
+	consumerTest, _ := createWorkspace(t, client, orgTest)
+
+	_, err := client.Workspaces.ConfigureRemoteStateSharing(ctx, wTest.ID, WorkspaceRemoteStateSharingOptions{
+		GlobalRemoteState: Bool(false),
+		ConsumerIDs:       []string{consumerTest.ID},
+	})
+	require.NoError(t, err)
+
+	t.Run("without list options", func(t *testing.T) {
+		wl, err := client.Workspaces.RemoteStateConsumers(ctx, wTest.ID, ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 1)
+		assert.Equal(t, consumerTest.ID, wl.Items[0].ID)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		wl, err := client.Workspaces.RemoteStateConsumers(ctx, badIdentifier, ListOptions{})
+		assert.Nil(t, wl)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestWorkspacesConfigureRemoteStateSharing(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, _ := createWorkspace(t, client, orgTest)
+	consumerTest, _ := createWorkspace(t, client, orgTest)
+
+	t.Run("with explicit consumers", func(t *testing.T) {
+		w, err := client.Workspaces.ConfigureRemoteStateSharing(ctx, wTest.ID, WorkspaceRemoteStateSharingOptions{
+			GlobalRemoteState: Bool(false),
+			ConsumerIDs:       []string{consumerTest.ID},
+		})
+		require.NoError(t, err)
+		assert.False(t, w.GlobalRemoteState)
+
+		wl, err := client.Workspaces.RemoteStateConsumers(ctx, wTest.ID, ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 1)
+		assert.Equal(t, consumerTest.ID, wl.Items[0].ID)
+	})
+
+	t.Run("when enabling global remote state", func(t *testing.T) {
+		w, err := client.Workspaces.ConfigureRemoteStateSharing(ctx, wTest.ID, WorkspaceRemoteStateSharingOptions{
+			GlobalRemoteState: Bool(true),
+		})
+		require.NoError(t, err)
+		assert.True(t, w.GlobalRemoteState)
+	})
+
+	t.Run("when removing all consumers", func(t *testing.T) {
+		_, err := client.Workspaces.ConfigureRemoteStateSharing(ctx, wTest.ID, WorkspaceRemoteStateSharingOptions{
+			GlobalRemoteState: Bool(false),
+			ConsumerIDs:       []string{},
+		})
+		require.NoError(t, err)
+
+		wl, err := client.Workspaces.RemoteStateConsumers(ctx, wTest.ID, ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, wl.Items)
+	})
+
+	t.Run("with consumers while global remote state is enabled", func(t *testing.T) {
+		w, err := client.Workspaces.ConfigureRemoteStateSharing(ctx, wTest.ID, WorkspaceRemoteStateSharingOptions{
+			GlobalRemoteState: Bool(true),
+			ConsumerIDs:       []string{consumerTest.ID},
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "consumers can not be set when global remote state is enabled")
+	})
+
+	t.Run("without global remote state", func(t *testing.T) {
+		w, err := client.Workspaces.ConfigureRemoteStateSharing(ctx, wTest.ID, WorkspaceRemoteStateSharingOptions{})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "global remote state is required")
+	})
+
+	t.Run("with an invalid consumer ID", func(t *testing.T) {
+		w, err := client.Workspaces.ConfigureRemoteStateSharing(ctx, wTest.ID, WorkspaceRemoteStateSharingOptions{
+			GlobalRemoteState: Bool(false),
+			ConsumerIDs:       []string{badIdentifier},
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "invalid value for consumer ID")
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		w, err := client.Workspaces.ConfigureRemoteStateSharing(ctx, badIdentifier, WorkspaceRemoteStateSharingOptions{
+			GlobalRemoteState: Bool(true),
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestWorkspacesClearRelationships(t *testing.T) {
+	var body []byte
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(`{"data":{"id":"ws-123456789","type":"workspaces"}}`))
+	})
+
+	ctx := context.Background()
+
+	// decode returns the data object of the last request body.
+	decode := func(t *testing.T) map[string]interface{} {
+		var payload struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(body, &payload))
+		return payload.Data
+	}
+
+	t.Run("when removing the VCS connection", func(t *testing.T) {
+		_, err := client.Workspaces.RemoveVCSConnectionByID(ctx, "ws-123456789")
+		require.NoError(t, err)
+
+		attributes := decode(t)["attributes"].(map[string]interface{})
+		value, ok := attributes["vcs-repo"]
+		assert.True(t, ok)
+		assert.Nil(t, value)
+	})
+
+	t.Run("when unassigning the SSH key", func(t *testing.T) {
+		_, err := client.Workspaces.UnassignSSHKey(ctx, "ws-123456789")
+		require.NoError(t, err)
+
+		attributes := decode(t)["attributes"].(map[string]interface{})
+		value, ok := attributes["id"]
+		assert.True(t, ok)
+		assert.Nil(t, value)
+	})
+
+	t.Run("when removing the agent pool", func(t *testing.T) {
+		_, err := client.Workspaces.RemoveAgentPool(ctx, "ws-123456789")
+		require.NoError(t, err)
+
+		relationships := decode(t)["relationships"].(map[string]interface{})
+		relation, ok := relationships["agent-pool"].(map[string]interface{})
+		require.True(t, ok)
+		value, ok := relation["data"]
+		assert.True(t, ok)
+		assert.Nil(t, value)
+	})
+
+	t.Run("when updating without an agent pool", func(t *testing.T) {
+		_, err := client.Workspaces.UpdateByID(ctx, "ws-123456789", WorkspaceUpdateOptions{
+			Name: String("foo"),
+		})
+		require.NoError(t, err)
+
+		_, ok := decode(t)["relationships"]
+		assert.False(t, ok)
+	})
+}
+
+func TestWorkspacesReadWithOptions(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+
+	t.Run("when including the organization", func(t *testing.T) {
+		w, err := client.Workspaces.ReadWithOptions(ctx, orgTest.Name, wTest.Name, WorkspaceReadOptions{
+			Include: "organization",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, w.Organization)
+		assert.Equal(t, orgTest.Name, w.Organization.Name)
+		assert.Equal(t, orgTest.Email, w.Organization.Email)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		w, err := client.Workspaces.ReadWithOptions(ctx, badIdentifier, wTest.Name, WorkspaceReadOptions{})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "invalid value for organization")
+	})
+}
+
+func TestWorkspacesReadWithCurrentRun(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/tfe/v2/organizations/acme/workspaces/network", r.URL.Path)
+		assert.Equal(t, "current-run", r.URL.Query().Get("include"))
+		w.Write([]byte(`{
+			"data": {"id": "ws-123", "type": "workspaces", "attributes": {"name": "network"},
+				"relationships": {"current-run": {"data": {"id": "run-123", "type": "runs"}}}},
+			"included": [{"id": "run-123", "type": "runs", "attributes": {"status": "planned", "message": "Update routes"}}]}`))
+	})
+
+	w, err := client.Workspaces.ReadWithOptions(context.Background(), "acme", "network", WorkspaceReadOptions{
+		Include: "current-run",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, w.CurrentRun)
+	assert.Equal(t, "run-123", w.CurrentRun.ID)
+	assert.Equal(t, RunPlanned, w.CurrentRun.Status)
+	assert.Equal(t, "Update routes", w.CurrentRun.Message)
+}
+
+func TestWorkspacesReadByIDWithOptions(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+
+	t.Run("when including the organization", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByIDWithOptions(ctx, wTest.ID, WorkspaceReadOptions{
+			Include: "organization",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, w.Organization)
+		assert.Equal(t, orgTest.Name, w.Organization.Name)
+		assert.Equal(t, orgTest.Email, w.Organization.Email)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByIDWithOptions(ctx, badIdentifier, WorkspaceReadOptions{})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestWorkspacesExecutionMode(t *testing.T) {
+	var bodies []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bodies = append(bodies, string(body))
+
+		if strings.Contains(string(body), `"apool-456"`) {
+			w.Write([]byte(`{"data":{"id":"ws-123456789","type":"workspaces","attributes":{"execution-mode":"agent"},` +
+				`"relationships":{"agent-pool":{"data":{"id":"apool-456","type":"agent-pools"}}}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"id":"ws-123456789","type":"workspaces","attributes":{"execution-mode":"local"}}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("when switching to local execution", func(t *testing.T) {
+		bodies = nil
+
+		w, err := client.Workspaces.UpdateByID(ctx, "ws-123456789", WorkspaceUpdateOptions{
+			ExecutionMode: ExecutionMode(ExecutionModeLocal),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ExecutionModeLocal, w.ExecutionMode)
+		assert.Nil(t, w.AgentPool)
+
+		// The agent pool is detached in the same request.
+		require.Len(t, bodies, 1)
+		assert.Contains(t, bodies[0], `"execution-mode":"local"`)
+		assert.Contains(t, bodies[0], `"agent-pool":{"data":null}`)
+	})
+
+	t.Run("when moving an agent workspace to another pool", func(t *testing.T) {
+		bodies = nil
+
+		w, err := client.Workspaces.UpdateByID(ctx, "ws-123456789", WorkspaceUpdateOptions{
+			AgentPool: &AgentPool{ID: "apool-456"},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, w.AgentPool)
+		assert.Equal(t, "apool-456", w.AgentPool.ID)
+
+		require.Len(t, bodies, 1)
+		assert.NotContains(t, bodies[0], `"execution-mode"`)
+	})
+
+	t.Run("when creating with an agent pool and remote execution", func(t *testing.T) {
+		w, err := client.Workspaces.Create(ctx, "acme", WorkspaceCreateOptions{
+			Name:          String("foo"),
+			ExecutionMode: ExecutionMode(ExecutionModeRemote),
+			AgentPool:     &AgentPool{ID: "apool-123"},
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "agent pool can only be set when the execution mode is agent")
+	})
+
+	t.Run("when creating with agent execution without an agent pool", func(t *testing.T) {
+		w, err := client.Workspaces.Create(ctx, "acme", WorkspaceCreateOptions{
+			Name:          String("foo"),
+			ExecutionMode: ExecutionMode(ExecutionModeAgent),
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "agent pool is required when the execution mode is agent")
+	})
+
+	t.Run("when updating with an agent pool and remote execution", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateByID(ctx, "ws-123456789", WorkspaceUpdateOptions{
+			ExecutionMode: ExecutionMode(ExecutionModeRemote),
+			AgentPool:     &AgentPool{ID: "apool-123"},
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "agent pool can only be set when the execution mode is agent")
+	})
+
+	t.Run("when creating with an agent pool but no execution mode", func(t *testing.T) {
+		w, err := client.Workspaces.Create(ctx, "acme", WorkspaceCreateOptions{
+			Name:      String("foo"),
+			AgentPool: &AgentPool{ID: "apool-123"},
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "agent pool can only be set when the execution mode is agent")
+	})
+
+	t.Run("with an invalid execution mode", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateByID(ctx, "ws-123456789", WorkspaceUpdateOptions{
+			ExecutionMode: ExecutionMode("cloud"),
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "invalid value for execution mode")
+	})
+}
+
+func TestWorkspacesMutate(t *testing.T) {
+	var patches []string
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			patches = append(patches, string(body))
+			w.Write([]byte(`{"data":{"id":"ws-123456789","type":"workspaces","attributes":{"name":"foo","auto-apply":true,"terraform-version":"1.1.0"}}}`))
+			return
+		}
+
+		if r.URL.Path == "/api/tfe/v2/workspaces/ws-agent" {
+			w.Write([]byte(`{"data":{"id":"ws-agent","type":"workspaces","attributes":{"name":"bar","execution-mode":"agent"},` +
+				`"relationships":{"agent-pool":{"data":{"id":"apool-123","type":"agent-pools"}}}}}`))
+			return
+		}
+
+		w.Write([]byte(`{"data":{"id":"ws-123456789","type":"workspaces","attributes":{"name":"foo","auto-apply":true,"terraform-version":"1.0.0","trigger-prefixes":[]}}}`))
+	})
+
+	ctx := context.Background()
+
+	t.Run("when changing a single setting", func(t *testing.T) {
+		patches = nil
+
+		w, err := client.Workspaces.Mutate(ctx, "ws-123456789", func(w *Workspace) {
+			w.TerraformVersion = "1.1.0"
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1.1.0", w.TerraformVersion)
+
+		require.Len(t, patches, 1)
+		var payload struct {
+			Data struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(patches[0]), &payload))
+		assert.Equal(t, map[string]interface{}{"terraform-version": "1.1.0"}, payload.Data.Attributes)
+	})
+
+	t.Run("when nothing is changed", func(t *testing.T) {
+		patches = nil
+
+		w, err := client.Workspaces.Mutate(ctx, "ws-123456789", func(w *Workspace) {
+			w.AutoApply = true
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1.0.0", w.TerraformVersion)
+		assert.Empty(t, patches)
+	})
+
+	t.Run("when detaching the agent pool", func(t *testing.T) {
+		patches = nil
+
+		_, err := client.Workspaces.Mutate(ctx, "ws-agent", func(w *Workspace) {
+			w.AgentPool = nil
+			w.ExecutionMode = ExecutionModeRemote
+		})
+		require.NoError(t, err)
+
+		require.Len(t, patches, 1)
+		assert.Contains(t, patches[0], `"agent-pool":{"data":null}`)
+	})
+
+	t.Run("when detaching the agent pool in agent execution mode", func(t *testing.T) {
+		patches = nil
+
+		w, err := client.Workspaces.Mutate(ctx, "ws-agent", func(w *Workspace) {
+			w.AgentPool = nil
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "agent pool is required when the execution mode is agent")
+		assert.Empty(t, patches)
+	})
+
+	t.Run("without a mutation", func(t *testing.T) {
+		w, err := client.Workspaces.Mutate(ctx, "ws-123456789", nil)
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "mutate is required")
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		w, err := client.Workspaces.Mutate(ctx, badIdentifier, func(w *Workspace) {})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestWorkspacesLockedBy(t *testing.T) {
+	client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tfe/v2/workspaces/ws-run":
+			w.Write([]byte(`{"data":{"id":"ws-run","type":"workspaces","attributes":{"locked":true},` +
+				`"relationships":{"locked-by":{"data":{"id":"run-abc","type":"runs"}}}}}`))
+		case "/api/tfe/v2/workspaces/ws-user":
+			w.Write([]byte(`{"data":{"id":"ws-user","type":"workspaces","attributes":{"locked":true},` +
+				`"relationships":{"locked-by":{"data":{"id":"user-jdoe","type":"users"}}}}}`))
+		case "/api/tfe/v2/workspaces/ws-unlocked":
+			w.Write([]byte(`{"data":{"id":"ws-unlocked","type":"workspaces","attributes":{"locked":false},` +
+				`"relationships":{"locked-by":{"data":null}}}}`))
+		case "/api/tfe/v2/organizations/acme/workspaces":
+			w.Write([]byte(`{"data":[` +
+				`{"id":"ws-team","type":"workspaces","relationships":{"locked-by":{"data":{"id":"team-ops","type":"teams"}}}},` +
+				`{"id":"ws-unlocked","type":"workspaces"}` +
+				`],"meta":{"pagination":{"current-page":1,"total-pages":1,"total-count":2}}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	})
+
+	ctx := context.Background()
+
+	t.Run("when locked by a run", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByID(ctx, "ws-run")
+		require.NoError(t, err)
+		require.NotNil(t, w.LockedBy)
+		require.NotNil(t, w.LockedBy.Run)
+		assert.Equal(t, "run-abc", w.LockedBy.Run.ID)
+		assert.Nil(t, w.LockedBy.User)
+		assert.Nil(t, w.LockedBy.Team)
+	})
+
+	t.Run("when locked by a user", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByID(ctx, "ws-user")
+		require.NoError(t, err)
+		require.NotNil(t, w.LockedBy)
+		require.NotNil(t, w.LockedBy.User)
+		assert.Equal(t, "user-jdoe", w.LockedBy.User.ID)
+	})
+
+	t.Run("when not locked", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByID(ctx, "ws-unlocked")
+		require.NoError(t, err)
+		assert.Nil(t, w.LockedBy)
+	})
+
+	t.Run("when listing workspaces", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, "acme", WorkspaceListOptions{})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 2)
+		require.NotNil(t, wl.Items[0].LockedBy)
+		assert.Equal(t, "team-ops", wl.Items[0].LockedBy.Team.ID)
+		assert.Nil(t, wl.Items[1].LockedBy)
+	})
+}
+
+func TestWorkspaceCreateOptionsValidate(t *testing.T) {
+	t.Run("with valid options", func(t *testing.T) {
+		options := WorkspaceCreateOptions{
+			Name: String("foo"),
+		}
+		assert.NoError(t, options.Validate())
+	})
+
+	t.Run("without a name", func(t *testing.T) {
+		options := WorkspaceCreateOptions{}
+		assert.EqualError(t, options.Validate(), "name is required")
+	})
+
+	t.Run("with an invalid name", func(t *testing.T) {
+		options := WorkspaceCreateOptions{
+			Name: String(badIdentifier),
+		}
+		assert.EqualError(t, options.Validate(), "invalid value for name")
+	})
+}